@@ -0,0 +1,195 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is matched by errors returned by a Breaker which does
+// not call its function because the circuit is open.
+var ErrCircuitOpen = errors.New("result: circuit open")
+
+// CircuitOpenError is returned by a Breaker which does not call its
+// function because the circuit is open. It matches ErrCircuitOpen.
+type CircuitOpenError struct {
+	// RetryAt is when the circuit will next allow a call.
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("result: circuit open until %s", e.RetryAt.Format(time.RFC3339))
+}
+
+// Is reports whether the target is ErrCircuitOpen.
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// BreakerState is the state of a Breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed calls the function and counts its errors.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects calls until the cooldown has passed.
+	BreakerOpen
+	// BreakerHalfOpen allows a single trial call, which closes the
+	// circuit if it succeeds and opens it again if it fails.
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("BreakerState(%d)", int(s))
+}
+
+// Breaker is a circuit breaker for functions returning results, which
+// are called through it with Do. It opens after `Threshold` consecutive
+// counted errors, returning a CircuitOpenError without calling the
+// function until `Cooldown` has passed. It then half-opens, allowing one
+// trial call to decide whether to close or open again.
+//
+// The zero value is a closed breaker which opens after a single error
+// and half-opens immediately. A Breaker must not be copied after first
+// use, and its fields must not be changed after first use.
+type Breaker struct {
+	// Threshold is the number of consecutive counted errors which opens
+	// the circuit. Values below 1 are treated as 1.
+	Threshold int
+	// Cooldown is how long the circuit stays open before half-opening.
+	Cooldown time.Duration
+	// ShouldCount reports whether an error counts as a failure. If nil,
+	// every error counts. Errors which are not counted are treated like
+	// `Ok` results.
+	ShouldCount func(error) bool
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+	// OnStateChange, if not nil, is called after every change of state.
+	// It is called without holding the lock of the breaker.
+	OnStateChange func(from, to BreakerState)
+
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// Do calls `f` through the breaker and records its result if the
+// circuit allows it, and otherwise returns an `Err` holding a
+// CircuitOpenError. A panic in `f` is recorded as a counted failure
+// before it is propagated. Calls returning different types of results
+// share the state of the breaker.
+func Do[T any](b *Breaker, f func() Result[T]) Result[T] {
+	trial, err := b.allow()
+	if err != nil {
+		return Err[T](err)
+	}
+	recorded := false
+	defer func() {
+		if !recorded {
+			b.record(trial, true)
+		}
+	}()
+	r := f()
+	recorded = true
+	b.record(trial, r.IsErr() && (b.ShouldCount == nil || b.ShouldCount(r.err)))
+	return r
+}
+
+// State returns the current state of the breaker. An open breaker whose
+// cooldown has passed is reported as half-open.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerOpen && !b.now().Before(b.retryAt()) {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// allow returns a CircuitOpenError if a call is not allowed, and
+// otherwise whether the call is the trial call of a half-open breaker.
+func (b *Breaker) allow() (bool, error) {
+	b.mu.Lock()
+	from := b.state
+	if b.state == BreakerOpen && !b.now().Before(b.retryAt()) {
+		b.state = BreakerHalfOpen
+	}
+	var trial bool
+	var err error
+	switch {
+	case b.state == BreakerOpen:
+		err = &CircuitOpenError{RetryAt: b.retryAt()}
+	case b.state == BreakerHalfOpen && b.probing:
+		err = &CircuitOpenError{RetryAt: b.now()}
+	case b.state == BreakerHalfOpen:
+		b.probing = true
+		trial = true
+	}
+	to := b.state
+	b.mu.Unlock()
+	b.changed(from, to)
+	return trial, err
+}
+
+// record updates the state of the breaker with the outcome of a call.
+// Calls which were allowed while closed only count towards opening the
+// circuit if it is still closed.
+func (b *Breaker) record(trial, failed bool) {
+	b.mu.Lock()
+	from := b.state
+	switch {
+	case trial:
+		b.probing = false
+		if failed {
+			b.open()
+		} else {
+			b.state = BreakerClosed
+			b.failures = 0
+		}
+	case b.state != BreakerClosed:
+	case failed:
+		b.failures++
+		if b.failures >= b.Threshold {
+			b.open()
+		}
+	default:
+		b.failures = 0
+	}
+	to := b.state
+	b.mu.Unlock()
+	b.changed(from, to)
+}
+
+func (b *Breaker) open() {
+	b.state = BreakerOpen
+	b.failures = 0
+	b.openedAt = b.now()
+}
+
+func (b *Breaker) retryAt() time.Time {
+	return b.openedAt.Add(b.Cooldown)
+}
+
+func (b *Breaker) changed(from, to BreakerState) {
+	if from != to && b.OnStateChange != nil {
+		b.OnStateChange(from, to)
+	}
+}
+
+func (b *Breaker) now() time.Time {
+	if b.Now == nil {
+		return time.Now()
+	}
+	return b.Now()
+}
@@ -0,0 +1,184 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+var errDownstream = errors.New("downstream")
+
+func TestBreakerDo(t *testing.T) {
+	ok := result.Ok(1)
+	failed := result.Err[int](errDownstream)
+	ignored := result.Err[int](errors.New("ignored"))
+
+	type call struct {
+		advance  time.Duration
+		result   result.Result[int]
+		called   bool
+		expected result.BreakerState
+	}
+	tests := map[string]struct {
+		threshold int
+		calls     []call
+	}{
+		"stays closed below threshold": {
+			threshold: 3,
+			calls: []call{
+				{result: failed, called: true, expected: result.BreakerClosed},
+				{result: failed, called: true, expected: result.BreakerClosed},
+				{result: ok, called: true, expected: result.BreakerClosed},
+				{result: failed, called: true, expected: result.BreakerClosed},
+				{result: failed, called: true, expected: result.BreakerClosed},
+			},
+		},
+		"opens at threshold": {
+			threshold: 2,
+			calls: []call{
+				{result: failed, called: true, expected: result.BreakerClosed},
+				{result: failed, called: true, expected: result.BreakerOpen},
+				{advance: 59 * time.Second, result: ok, called: false, expected: result.BreakerOpen},
+			},
+		},
+		"uncounted errors do not open": {
+			threshold: 1,
+			calls: []call{
+				{result: ignored, called: true, expected: result.BreakerClosed},
+				{result: ignored, called: true, expected: result.BreakerClosed},
+			},
+		},
+		"half-open trial closes": {
+			threshold: 1,
+			calls: []call{
+				{result: failed, called: true, expected: result.BreakerOpen},
+				{advance: time.Minute, result: ok, called: true, expected: result.BreakerClosed},
+				{result: ok, called: true, expected: result.BreakerClosed},
+			},
+		},
+		"half-open trial failure reopens": {
+			threshold: 1,
+			calls: []call{
+				{result: failed, called: true, expected: result.BreakerOpen},
+				{advance: time.Minute, result: failed, called: true, expected: result.BreakerOpen},
+				{advance: 59 * time.Second, result: ok, called: false, expected: result.BreakerOpen},
+				{advance: time.Second, result: ok, called: true, expected: result.BreakerClosed},
+			},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			clock := &fakeClock{now: time.Unix(0, 0)}
+			b := &result.Breaker{
+				Threshold:   tc.threshold,
+				Cooldown:    time.Minute,
+				ShouldCount: func(err error) bool { return errors.Is(err, errDownstream) },
+				Now:         clock.Now,
+			}
+			for _, c := range tc.calls {
+				clock.Advance(c.advance)
+				called := false
+				got := result.Do(b, func() result.Result[int] {
+					called = true
+					return c.result
+				})
+				if called != c.called {
+					t.Fail()
+				}
+				if called && !got.Equal(c.result) {
+					t.Fail()
+				}
+				if !called && !got.IsErrAnd(func(err error) bool { return errors.Is(err, result.ErrCircuitOpen) }) {
+					t.Fail()
+				}
+				if state := b.State(); state != c.expected {
+					t.Fail()
+				}
+			}
+		})
+	}
+}
+
+func TestBreakerDoHalfOpenSingleTrial(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	b := &result.Breaker{Cooldown: time.Minute, Now: clock.Now}
+	result.Do(b, func() result.Result[int] { return result.Err[int](errDownstream) })
+	clock.Advance(time.Minute)
+
+	var inner result.Result[int]
+	result.Do(b, func() result.Result[int] {
+		inner = result.Do(b, func() result.Result[int] { return result.Ok(2) })
+		return result.Ok(1)
+	})
+	var openErr *result.CircuitOpenError
+	if !errors.As(inner.UnwrapErr(), &openErr) {
+		t.Fail()
+	}
+}
+
+func TestBreakerDoSharedAcrossTypes(t *testing.T) {
+	b := &result.Breaker{Threshold: 2, Cooldown: time.Minute}
+	result.Do(b, func() result.Result[int] { return result.Err[int](errDownstream) })
+	result.Do(b, func() result.Result[string] { return result.Err[string](errDownstream) })
+	called := false
+	r := result.Do(b, func() result.Result[bool] {
+		called = true
+		return result.Ok(true)
+	})
+	if called || !r.ContainsErr(result.ErrCircuitOpen) {
+		t.Fail()
+	}
+}
+
+func TestBreakerDoPanic(t *testing.T) {
+	b := &result.Breaker{Cooldown: time.Minute}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+		result.Do(b, func() result.Result[int] { panic("failed") })
+	}()
+	if state := b.State(); state != result.BreakerOpen {
+		t.Fail()
+	}
+}
+
+func TestBreakerOnStateChange(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	var changes []string
+	b := &result.Breaker{
+		Cooldown: time.Minute,
+		Now:      clock.Now,
+		OnStateChange: func(from, to result.BreakerState) {
+			changes = append(changes, from.String()+"->"+to.String())
+		},
+	}
+	result.Do(b, func() result.Result[int] { return result.Err[int](errDownstream) })
+	clock.Advance(time.Minute)
+	result.Do(b, func() result.Result[int] { return result.Ok(1) })
+
+	expected := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if len(changes) != len(expected) {
+		t.FailNow()
+	}
+	for i := range expected {
+		if changes[i] != expected[i] {
+			t.Fail()
+		}
+	}
+}
+
+func TestCircuitOpenError(t *testing.T) {
+	err := &result.CircuitOpenError{RetryAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	if err.Error() != "result: circuit open until 2024-01-02T03:04:05Z" {
+		t.Fail()
+	}
+	if !errors.Is(err, result.ErrCircuitOpen) {
+		t.Fail()
+	}
+}
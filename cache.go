@@ -0,0 +1,154 @@
+package result
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Cache caches the results of loading values by key. Concurrent loads of
+// the same key are deduplicated, so that the load function is called once
+// and every caller receives its result. `Ok` results are cached for
+// `OkTTL` and `Err` results for `ErrTTL`; a non-positive TTL disables
+// caching of that kind of result.
+//
+// The zero value is an empty cache which caches nothing. A Cache must not
+// be copied after first use, and its fields must not be changed after
+// first use.
+type Cache[K comparable, V any] struct {
+	// OkTTL is how long `Ok` results are cached.
+	OkTTL time.Duration
+	// ErrTTL is how long `Err` results are cached.
+	ErrTTL time.Duration
+	// Now returns the current time. If nil, time.Now is used.
+	Now func() time.Time
+
+	mu      sync.Mutex
+	entries map[K]cacheEntry[V]
+	calls   map[K]*cacheCall[V]
+	stats   CacheStats
+}
+
+// CacheStats counts the outcomes of calls to Cache.Get.
+type CacheStats struct {
+	// Hits counts results returned from the cache.
+	Hits int
+	// Shared counts results returned from a load started by another call.
+	Shared int
+	// Misses counts calls which loaded a value.
+	Misses int
+	// Errs counts loads which returned an `Err` result.
+	Errs int
+}
+
+type cacheEntry[V any] struct {
+	result  Result[V]
+	expires time.Time
+}
+
+type cacheCall[V any] struct {
+	done   chan struct{}
+	result Result[V]
+}
+
+// Get returns the cached result for the key if it has not expired.
+// Otherwise it waits for a load of the key already in progress, or calls
+// `load` and caches its result. If `load` panics, the panic is propagated
+// and concurrent callers waiting for it receive an `Err`.
+func (c *Cache[K, V]) Get(key K, load func(K) Result[V]) Result[V] {
+	c.mu.Lock()
+	if e, ok := c.entries[key]; ok {
+		if c.now().Before(e.expires) {
+			c.stats.Hits++
+			c.mu.Unlock()
+			return e.result
+		}
+		delete(c.entries, key)
+	}
+	if call, ok := c.calls[key]; ok {
+		c.stats.Shared++
+		c.mu.Unlock()
+		<-call.done
+		return call.result
+	}
+	call := &cacheCall[V]{
+		done:   make(chan struct{}),
+		result: Err[V](fmt.Errorf("result: load of cache key %v panicked", key)),
+	}
+	if c.calls == nil {
+		c.calls = map[K]*cacheCall[V]{}
+	}
+	c.calls[key] = call
+	c.stats.Misses++
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.calls[key] == call {
+			delete(c.calls, key)
+		}
+		close(call.done)
+	}()
+	call.result = load(key)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ttl := c.OkTTL
+	if call.result.IsErr() {
+		c.stats.Errs++
+		ttl = c.ErrTTL
+	}
+	// A key invalidated during the load is not cached.
+	if ttl > 0 && c.calls[key] == call {
+		if c.entries == nil {
+			c.entries = map[K]cacheEntry[V]{}
+		}
+		c.entries[key] = cacheEntry[V]{result: call.result, expires: c.now().Add(ttl)}
+	}
+	return call.result
+}
+
+// Invalidate removes the key from the cache. A load of the key in
+// progress is not cached when it completes, and later calls to Get load
+// the key again.
+func (c *Cache[K, V]) Invalidate(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, key)
+	delete(c.calls, key)
+}
+
+// Purge removes every expired entry from the cache.
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.now()
+	for key, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache, including expired
+// entries which have not been purged.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Stats returns the counts of outcomes of calls to Get so far.
+func (c *Cache[K, V]) Stats() CacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+func (c *Cache[K, V]) now() time.Time {
+	if c.Now == nil {
+		return time.Now()
+	}
+	return c.Now()
+}
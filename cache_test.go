@@ -0,0 +1,199 @@
+package result_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestCacheGet(t *testing.T) {
+	type step struct {
+		advance  time.Duration
+		key      string
+		expected result.Result[int]
+	}
+	tests := map[string]struct {
+		okTTL, errTTL time.Duration
+		steps         []step
+		stats         result.CacheStats
+	}{
+		"ok cached until ttl": {
+			okTTL: time.Minute,
+			steps: []step{
+				{key: "a", expected: result.Ok(1)},
+				{advance: 59 * time.Second, key: "a", expected: result.Ok(1)},
+				{advance: time.Second, key: "a", expected: result.Ok(2)},
+			},
+			stats: result.CacheStats{Hits: 1, Misses: 2},
+		},
+		"keys cached separately": {
+			okTTL: time.Minute,
+			steps: []step{
+				{key: "a", expected: result.Ok(1)},
+				{key: "b", expected: result.Ok(2)},
+				{key: "a", expected: result.Ok(1)},
+			},
+			stats: result.CacheStats{Hits: 1, Misses: 2},
+		},
+		"err cached for negative ttl": {
+			okTTL:  time.Minute,
+			errTTL: time.Second,
+			steps: []step{
+				{key: "err", expected: result.Err[int](errors.New("err 1"))},
+				{key: "err", expected: result.Err[int](errors.New("err 1"))},
+				{advance: time.Second, key: "err", expected: result.Err[int](errors.New("err 2"))},
+			},
+			stats: result.CacheStats{Hits: 1, Misses: 2, Errs: 2},
+		},
+		"err not cached without negative ttl": {
+			okTTL: time.Minute,
+			steps: []step{
+				{key: "err", expected: result.Err[int](errors.New("err 1"))},
+				{key: "err", expected: result.Err[int](errors.New("err 2"))},
+			},
+			stats: result.CacheStats{Misses: 2, Errs: 2},
+		},
+		"zero value caches nothing": {
+			steps: []step{
+				{key: "a", expected: result.Ok(1)},
+				{key: "a", expected: result.Ok(2)},
+			},
+			stats: result.CacheStats{Misses: 2},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			clock := &fakeClock{now: time.Unix(0, 0)}
+			cache := &result.Cache[string, int]{OkTTL: tc.okTTL, ErrTTL: tc.errTTL, Now: clock.Now}
+			loads := 0
+			load := func(key string) result.Result[int] {
+				loads++
+				if key == "err" {
+					return result.Err[int](errors.New("err " + string(rune('0'+loads))))
+				}
+				return result.Ok(loads)
+			}
+			for _, s := range tc.steps {
+				clock.Advance(s.advance)
+				if got := cache.Get(s.key, load); !got.Equal(s.expected) {
+					t.Fail()
+				}
+			}
+			if stats := cache.Stats(); stats != tc.stats {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestCacheGetDeduplicates(t *testing.T) {
+	cache := &result.Cache[string, int]{OkTTL: time.Minute}
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var loads int
+	load := func(string) result.Result[int] {
+		loads++
+		close(started)
+		<-release
+		return result.Ok(1)
+	}
+
+	var wg sync.WaitGroup
+	results := make([]result.Result[int], 10)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0] = cache.Get("a", load)
+	}()
+	<-started
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = cache.Get("a", load)
+		}(i)
+	}
+	for cache.Stats().Shared < len(results)-1 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if loads != 1 {
+		t.Fail()
+	}
+	for _, r := range results {
+		if !r.Equal(result.Ok(1)) {
+			t.Fail()
+		}
+	}
+}
+
+func TestCacheGetPanic(t *testing.T) {
+	cache := &result.Cache[string, int]{OkTTL: time.Minute}
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+		cache.Get("a", func(string) result.Result[int] { panic("load failed") })
+	}()
+	if got := cache.Get("a", func(string) result.Result[int] { return result.Ok(1) }); !got.Equal(result.Ok(1)) {
+		t.Fail()
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	cache := &result.Cache[string, int]{OkTTL: time.Minute}
+	cache.Get("a", func(string) result.Result[int] { return result.Ok(1) })
+	cache.Invalidate("a")
+	if got := cache.Get("a", func(string) result.Result[int] { return result.Ok(2) }); !got.Equal(result.Ok(2)) {
+		t.Fail()
+	}
+
+	// A load which is invalidated while in progress is not cached.
+	cache.Get("b", func(key string) result.Result[int] {
+		cache.Invalidate(key)
+		return result.Ok(1)
+	})
+	if got := cache.Get("b", func(string) result.Result[int] { return result.Ok(2) }); !got.Equal(result.Ok(2)) {
+		t.Fail()
+	}
+}
+
+func TestCachePurge(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := &result.Cache[string, int]{OkTTL: time.Minute, ErrTTL: time.Second, Now: clock.Now}
+	cache.Get("ok", func(string) result.Result[int] { return result.Ok(1) })
+	cache.Get("err", func(string) result.Result[int] { return result.Err[int](errors.New("failed")) })
+	if cache.Len() != 2 {
+		t.FailNow()
+	}
+	clock.Advance(time.Second)
+	cache.Purge()
+	if cache.Len() != 1 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,157 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefinedErr is a domain error declared once with DefineErr, along with
+// metadata describing it. Errors created from it with New or ErrOf
+// match it according to errors.Is, whatever their details.
+type DefinedErr struct {
+	code      string
+	status    int
+	message   string
+	category  string
+	retryable bool
+}
+
+// DefineOption sets optional metadata of an error declared with
+// DefineErr.
+type DefineOption func(*DefinedErr)
+
+// InCategory sets the category of a defined error.
+func InCategory(category string) DefineOption {
+	return func(d *DefinedErr) {
+		d.category = category
+	}
+}
+
+// Retryable marks a defined error as retryable.
+func Retryable() DefineOption {
+	return func(d *DefinedErr) {
+		d.retryable = true
+	}
+}
+
+var errCatalog = struct {
+	sync.RWMutex
+	defs []*DefinedErr
+}{}
+
+// DefineErr declares a domain error with a stable code, the HTTP status
+// it is reported with, and its message, and adds it to the catalog. The
+// code is also registered with RegisterErr, so the error survives
+// encoding. It panics if the code is invalid or already registered, or
+// if the status is not a valid HTTP status code.
+func DefineErr(code string, status int, message string, opts ...DefineOption) *DefinedErr {
+	checkStatus(status)
+	d := &DefinedErr{code: code, status: status, message: message}
+	for _, opt := range opts {
+		opt(d)
+	}
+	RegisterErr(code, d)
+	errCatalog.Lock()
+	defer errCatalog.Unlock()
+	errCatalog.defs = append(errCatalog.defs, d)
+	return d
+}
+
+// Catalog returns every defined error sorted by code, for generating
+// documentation and API error tables.
+func Catalog() []*DefinedErr {
+	errCatalog.RLock()
+	defs := append([]*DefinedErr(nil), errCatalog.defs...)
+	errCatalog.RUnlock()
+	sort.Slice(defs, func(i, j int) bool { return defs[i].code < defs[j].code })
+	return defs
+}
+
+// Code returns the stable code of the error.
+func (d *DefinedErr) Code() string {
+	return d.code
+}
+
+// Status returns the HTTP status the error is reported with.
+func (d *DefinedErr) Status() int {
+	return d.status
+}
+
+// Message returns the message of the error without details.
+func (d *DefinedErr) Message() string {
+	return d.message
+}
+
+// Category returns the category of the error, or an empty string if
+// it has none.
+func (d *DefinedErr) Category() string {
+	return d.category
+}
+
+// Retryable reports whether an operation failing with the error may
+// succeed if retried.
+func (d *DefinedErr) Retryable() bool {
+	return d.retryable
+}
+
+// Error returns the message of the error.
+func (d *DefinedErr) Error() string {
+	return d.message
+}
+
+// Is reports whether the target is an error created from the
+// definition with New, so that errors match whatever their details in
+// either direction.
+func (d *DefinedErr) Is(target error) bool {
+	t, ok := target.(*detailedErr)
+	return ok && t.def == d
+}
+
+// New returns an error matching the defined error whose message has the
+// details appended, separated by spaces.
+func (d *DefinedErr) New(details ...any) error {
+	if len(details) == 0 {
+		return d
+	}
+	return &detailedErr{def: d, details: strings.TrimSuffix(fmt.Sprintln(details...), "\n")}
+}
+
+// ErrOf returns an `Err` value with an error matching the defined error
+// whose message has the details appended. It is a function rather than
+// a method of DefinedErr because methods cannot have type parameters.
+func ErrOf[T any](d *DefinedErr, details ...any) Result[T] {
+	return Err[T](d.New(details...))
+}
+
+// DefinitionOf returns the defined error the error matches, if any.
+func DefinitionOf(err error) (*DefinedErr, bool) {
+	var d *DefinedErr
+	if errors.As(err, &d) {
+		return d, true
+	}
+	return nil, false
+}
+
+// detailedErr is a defined error with details added to its message.
+type detailedErr struct {
+	def     *DefinedErr
+	details string
+}
+
+func (e *detailedErr) Error() string {
+	return e.def.message + ": " + e.details
+}
+
+func (e *detailedErr) Unwrap() error {
+	return e.def
+}
+
+// Is reports whether the target is an error created from the same
+// definition, so that errors match whatever their details.
+func (e *detailedErr) Is(target error) bool {
+	t, ok := target.(*detailedErr)
+	return ok && t.def == e.def
+}
@@ -0,0 +1,253 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+var (
+	errResourceGone = result.DefineErr("test/catalog/gone", http.StatusGone, "resource gone")
+	errUnavailable  = result.DefineErr("test/catalog/unavailable", http.StatusServiceUnavailable, "service unavailable",
+		result.InCategory("dependency"), result.Retryable())
+)
+
+func TestDefineErr(t *testing.T) {
+	if errUnavailable.Code() != "test/catalog/unavailable" ||
+		errUnavailable.Status() != http.StatusServiceUnavailable ||
+		errUnavailable.Message() != "service unavailable" ||
+		errUnavailable.Category() != "dependency" ||
+		!errUnavailable.Retryable() {
+		t.Fail()
+	}
+	if errResourceGone.Category() != "" || errResourceGone.Retryable() {
+		t.Fail()
+	}
+}
+
+func TestDefineErrPanics(t *testing.T) {
+	tests := map[string]string{
+		"duplicate": "test/catalog/gone",
+		"invalid":   "bad code",
+	}
+
+	for tname, code := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+			result.DefineErr(code, http.StatusTeapot, "teapot")
+		})
+	}
+}
+
+func TestDefineErrInvalidStatus(t *testing.T) {
+	tests := map[string]int{
+		"zero":  0,
+		"small": 99,
+		"large": 600,
+	}
+
+	for tname, status := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+			result.DefineErr("test/catalog/invalid_status_"+tname, status, "invalid")
+		})
+	}
+}
+
+func TestDefinedErrNew(t *testing.T) {
+	tests := map[string]struct {
+		details  []any
+		expected string
+	}{
+		"no details": {
+			details:  nil,
+			expected: "resource gone",
+		},
+		"details": {
+			details:  []any{"user", 42},
+			expected: "resource gone: user 42",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			err := errResourceGone.New(tc.details...)
+			if err.Error() != tc.expected {
+				t.Fail()
+			}
+			if !errors.Is(err, errResourceGone) {
+				t.Fail()
+			}
+			if errors.Is(err, errUnavailable) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestErrOf(t *testing.T) {
+	r := result.ErrOf[int](errResourceGone, "user", 1)
+	tests := map[string]struct {
+		target   error
+		expected bool
+	}{
+		"definition":            {target: errResourceGone, expected: true},
+		"same details":          {target: errResourceGone.New("user", 1), expected: true},
+		"different details":     {target: errResourceGone.New("user", 2), expected: true},
+		"wrapped":               {target: fmt.Errorf("lookup: %w", errResourceGone), expected: false},
+		"different definition":  {target: errUnavailable, expected: false},
+		"different with detail": {target: errUnavailable.New("user", 1), expected: false},
+	}
+
+	if r.UnwrapErr().Error() != "resource gone: user 1" {
+		t.Fail()
+	}
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if r.ContainsErr(tc.target) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestContainsErrDetailed(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		target   error
+		expected bool
+	}{
+		"definition_contains_detailed": {
+			value:    result.ErrOf[int](errResourceGone),
+			target:   errResourceGone.New("id 1"),
+			expected: true,
+		},
+		"detailed_contains_detailed": {
+			value:    result.ErrOf[int](errResourceGone, "id 2"),
+			target:   errResourceGone.New("id 1"),
+			expected: true,
+		},
+		"other_definition": {
+			value:    result.ErrOf[int](errUnavailable),
+			target:   errResourceGone.New("id 1"),
+			expected: false,
+		},
+		"plain_error": {
+			value:    result.Err[int](errors.New("resource gone")),
+			target:   errResourceGone.New("id 1"),
+			expected: false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.ContainsErr(tc.target) != tc.expected {
+				t.Fail()
+			}
+			text, err := tc.value.MarshalText()
+			if err != nil {
+				t.FailNow()
+			}
+			var decoded result.Result[int]
+			if err := decoded.UnmarshalText(text); err != nil {
+				t.FailNow()
+			}
+			if decoded.ContainsErr(tc.target) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestEqualDetailed(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		other    result.Result[int]
+		expected bool
+	}{
+		"same_details": {
+			value:    result.ErrOf[int](errResourceGone, "user 1"),
+			other:    result.ErrOf[int](errResourceGone, "user 1"),
+			expected: true,
+		},
+		"different_details": {
+			value:    result.ErrOf[int](errResourceGone, "user 1"),
+			other:    result.ErrOf[int](errResourceGone, "user 2"),
+			expected: false,
+		},
+		"definition_and_detailed": {
+			value:    result.ErrOf[int](errResourceGone),
+			other:    result.ErrOf[int](errResourceGone, "user 1"),
+			expected: false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.Equal(tc.other) != tc.expected || tc.other.Equal(tc.value) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestDefinitionOf(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		expected *result.DefinedErr
+	}{
+		"definition": {err: errResourceGone, expected: errResourceGone},
+		"detailed":   {err: errUnavailable.New("db"), expected: errUnavailable},
+		"wrapped":    {err: fmt.Errorf("lookup: %w", errResourceGone.New("user")), expected: errResourceGone},
+		"undefined":  {err: errors.New("other"), expected: nil},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			d, ok := result.DefinitionOf(tc.err)
+			if d != tc.expected || ok != (tc.expected != nil) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestCatalog(t *testing.T) {
+	var codes []string
+	for _, d := range result.Catalog() {
+		if strings.HasPrefix(d.Code(), "test/catalog/") {
+			codes = append(codes, d.Code())
+		}
+	}
+	expected := []string{"test/catalog/gone", "test/catalog/unavailable"}
+	if strings.Join(codes, ",") != strings.Join(expected, ",") {
+		t.Fail()
+	}
+}
+
+func TestDefinedErrEncoding(t *testing.T) {
+	r := result.ErrOf[int](errUnavailable, "db")
+	text, err := r.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded result.Result[int]
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.ContainsErr(errUnavailable) || decoded.UnwrapErr().Error() != "service unavailable: db" {
+		t.Fail()
+	}
+}
@@ -0,0 +1,177 @@
+package result
+
+import (
+	"context"
+	"sync"
+)
+
+// Drain reads every result from the channel until it is closed and
+// collects the `Ok` values into a Result[[]T]. If any result is `Err`,
+// the first error is returned once the channel has been fully drained.
+func Drain[T any](ch <-chan Result[T]) Result[[]T] {
+	var data []T
+	var err error
+	for r := range ch {
+		if err != nil {
+			continue
+		}
+		if r.IsErr() {
+			err = r.err
+			continue
+		}
+		data = append(data, r.data)
+	}
+	if err != nil {
+		return Err[[]T](err)
+	}
+	return Ok(data)
+}
+
+// DrainContext reads results from the channel until it is closed and
+// collects the `Ok` values into a Result[[]T]. Unlike Drain, it stops
+// reading at the first `Err` value, or returns `Err` with the context's
+// error if the context is done first. Callers that need the producer to
+// stop as well should cancel the context once DrainContext returns.
+func DrainContext[T any](ctx context.Context, ch <-chan Result[T]) Result[[]T] {
+	var data []T
+	for {
+		select {
+		case <-ctx.Done():
+			return Err[[]T](ctx.Err())
+		case r, ok := <-ch:
+			if !ok {
+				return Ok(data)
+			}
+			if r.IsErr() {
+				return Err[[]T](r.err)
+			}
+			data = append(data, r.data)
+		}
+	}
+}
+
+// Split separates a channel of results into a channel of `Ok` values
+// and a channel of `Err` values. Both returned channels are closed once
+// the input channel is closed. Both channels must be consumed, as a
+// value waiting on one of them blocks delivery to the other.
+func Split[T any](ch <-chan Result[T]) (<-chan T, <-chan error) {
+	oks := make(chan T)
+	errs := make(chan error)
+	go func() {
+		defer close(oks)
+		defer close(errs)
+		for r := range ch {
+			if r.IsErr() {
+				errs <- r.err
+			} else {
+				oks <- r.data
+			}
+		}
+	}()
+	return oks, errs
+}
+
+// AndThenChan calls `f` on each `Ok` value received from the channel
+// using up to `workers` concurrent calls, passing `Err` values through
+// untouched. Results are emitted in the same order they were received.
+// The returned channel is closed when the input channel is closed and
+// all calls have finished, or when the context is done.
+func AndThenChan[T any, U any](ctx context.Context, ch <-chan Result[T], workers int, f func(T) Result[U]) <-chan Result[U] {
+	if workers < 1 {
+		workers = 1
+	}
+	out := make(chan Result[U])
+	pending := make(chan chan Result[U], workers)
+	sem := make(chan struct{}, workers)
+
+	go func() {
+		defer close(pending)
+		for {
+			var r Result[T]
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case r, ok = <-ch:
+				if !ok {
+					return
+				}
+			}
+			slot := make(chan Result[U], 1)
+			select {
+			case <-ctx.Done():
+				return
+			case sem <- struct{}{}:
+			}
+			select {
+			case <-ctx.Done():
+				<-sem
+				return
+			case pending <- slot:
+			}
+			go func() {
+				defer func() { <-sem }()
+				slot <- AndThen(r, f)
+			}()
+		}
+	}()
+
+	go func() {
+		defer close(out)
+		for slot := range pending {
+			var r Result[U]
+			select {
+			case <-ctx.Done():
+				return
+			case r = <-slot:
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case out <- r:
+			}
+		}
+	}()
+
+	return out
+}
+
+// AndThenChanUnordered calls `f` on each `Ok` value received from the
+// channel using `workers` goroutines, passing `Err` values through
+// untouched. Results are emitted as soon as they are ready, so their
+// order is not preserved. The returned channel is closed when the input
+// channel is closed and all calls have finished, or when the context
+// is done.
+func AndThenChanUnordered[T any, U any](ctx context.Context, ch <-chan Result[T], workers int, f func(T) Result[U]) <-chan Result[U] {
+	if workers < 1 {
+		workers = 1
+	}
+	out := make(chan Result[U])
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case r, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case out <- AndThen(r, f):
+					}
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
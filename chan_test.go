@@ -0,0 +1,275 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func sendAll[T any](values ...result.Result[T]) <-chan result.Result[T] {
+	ch := make(chan result.Result[T], len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func equalSlices[T comparable](r, other result.Result[[]T]) bool {
+	if r.IsOk() && other.IsOk() {
+		return reflect.DeepEqual(r.Unwrap(), other.Unwrap())
+	}
+	if r.IsErr() && other.IsErr() {
+		return r.UnwrapErr().Error() == other.UnwrapErr().Error()
+	}
+	return false
+}
+
+func TestDrain(t *testing.T) {
+	tests := map[string]struct {
+		values   []result.Result[int]
+		expected result.Result[[]int]
+	}{
+		"empty": {
+			values:   nil,
+			expected: result.Ok[[]int](nil),
+		},
+		"success": {
+			values:   []result.Result[int]{result.Ok(1), result.Ok(2), result.Ok(3)},
+			expected: result.Ok([]int{1, 2, 3}),
+		},
+		"error": {
+			values:   []result.Result[int]{result.Ok(1), result.Err[int](errors.New("first")), result.Err[int](errors.New("second"))},
+			expected: result.Err[[]int](errors.New("first")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ch := sendAll(tc.values...)
+			if !equalSlices(result.Drain(ch), tc.expected) {
+				t.Fail()
+			}
+			if len(ch) != 0 {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestDrainContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := map[string]struct {
+		ctx       context.Context
+		values    []result.Result[int]
+		expected  result.Result[[]int]
+		remaining int
+	}{
+		"success": {
+			ctx:       context.Background(),
+			values:    []result.Result[int]{result.Ok(1), result.Ok(2)},
+			expected:  result.Ok([]int{1, 2}),
+			remaining: 0,
+		},
+		"error_stops_early": {
+			ctx:       context.Background(),
+			values:    []result.Result[int]{result.Ok(1), result.Err[int](errors.New("failed")), result.Ok(3)},
+			expected:  result.Err[[]int](errors.New("failed")),
+			remaining: 1,
+		},
+		"context_done": {
+			ctx:       cancelled,
+			values:    nil,
+			expected:  result.Err[[]int](context.Canceled),
+			remaining: 0,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ch := make(chan result.Result[int], len(tc.values))
+			for _, v := range tc.values {
+				ch <- v
+			}
+			if tc.ctx.Err() == nil {
+				close(ch)
+			}
+			if !equalSlices(result.DrainContext(tc.ctx, ch), tc.expected) {
+				t.Fail()
+			}
+			if len(ch) != tc.remaining {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := map[string]struct {
+		values       []result.Result[int]
+		expectedOks  []int
+		expectedErrs []string
+	}{
+		"empty": {
+			values:       nil,
+			expectedOks:  nil,
+			expectedErrs: nil,
+		},
+		"mixed": {
+			values:       []result.Result[int]{result.Ok(1), result.Err[int](errors.New("a")), result.Ok(2), result.Err[int](errors.New("b"))},
+			expectedOks:  []int{1, 2},
+			expectedErrs: []string{"a", "b"},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			oks, errs := result.Split(sendAll(tc.values...))
+			var gotOks []int
+			var gotErrs []string
+			for oks != nil || errs != nil {
+				select {
+				case v, ok := <-oks:
+					if !ok {
+						oks = nil
+						continue
+					}
+					gotOks = append(gotOks, v)
+				case err, ok := <-errs:
+					if !ok {
+						errs = nil
+						continue
+					}
+					gotErrs = append(gotErrs, err.Error())
+				}
+			}
+			if !reflect.DeepEqual(gotOks, tc.expectedOks) || !reflect.DeepEqual(gotErrs, tc.expectedErrs) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAndThenChan(t *testing.T) {
+	toString := func(i int) result.Result[string] {
+		if i < 0 {
+			return result.Err[string](errors.New("negative"))
+		}
+		return result.Ok(strconv.Itoa(i))
+	}
+	tests := map[string]struct {
+		values   []result.Result[int]
+		workers  int
+		expected []result.Result[string]
+	}{
+		"success": {
+			values:   []result.Result[int]{result.Ok(1), result.Ok(2), result.Ok(3)},
+			workers:  2,
+			expected: []result.Result[string]{result.Ok("1"), result.Ok("2"), result.Ok("3")},
+		},
+		"error_passthrough": {
+			values:   []result.Result[int]{result.Ok(1), result.Err[int](errors.New("failed")), result.Ok(3)},
+			workers:  4,
+			expected: []result.Result[string]{result.Ok("1"), result.Err[string](errors.New("failed")), result.Ok("3")},
+		},
+		"error_from_f": {
+			values:   []result.Result[int]{result.Ok(-1), result.Ok(2)},
+			workers:  1,
+			expected: []result.Result[string]{result.Err[string](errors.New("negative")), result.Ok("2")},
+		},
+		"zero_workers": {
+			values:   []result.Result[int]{result.Ok(1)},
+			workers:  0,
+			expected: []result.Result[string]{result.Ok("1")},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var got []result.Result[string]
+			for r := range result.AndThenChan(context.Background(), sendAll(tc.values...), tc.workers, toString) {
+				got = append(got, r)
+			}
+			if len(got) != len(tc.expected) {
+				t.FailNow()
+			}
+			for i := range got {
+				if !result.Equal(got[i], tc.expected[i]) {
+					t.Fail()
+				}
+			}
+		})
+	}
+}
+
+func TestAndThenChanContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan result.Result[int])
+	out := result.AndThenChan(ctx, ch, 2, func(i int) result.Result[int] { return result.Ok(i) })
+	ch <- result.Ok(1)
+	if r := <-out; !result.Equal(r, result.Ok(1)) {
+		t.Fail()
+	}
+	cancel()
+	if _, ok := <-out; ok {
+		t.Fail()
+	}
+}
+
+func TestAndThenChanUnordered(t *testing.T) {
+	double := func(i int) result.Result[int] {
+		if i < 0 {
+			return result.Err[int](errors.New("negative"))
+		}
+		return result.Ok(i * 2)
+	}
+	tests := map[string]struct {
+		values       []result.Result[int]
+		workers      int
+		expectedOks  []int
+		expectedErrs int
+	}{
+		"success": {
+			values:       []result.Result[int]{result.Ok(1), result.Ok(2), result.Ok(3)},
+			workers:      3,
+			expectedOks:  []int{2, 4, 6},
+			expectedErrs: 0,
+		},
+		"errors": {
+			values:       []result.Result[int]{result.Ok(1), result.Err[int](errors.New("failed")), result.Ok(-3)},
+			workers:      2,
+			expectedOks:  []int{2},
+			expectedErrs: 2,
+		},
+		"zero_workers": {
+			values:       []result.Result[int]{result.Ok(5)},
+			workers:      0,
+			expectedOks:  []int{10},
+			expectedErrs: 0,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var oks []int
+			errs := 0
+			for r := range result.AndThenChanUnordered(context.Background(), sendAll(tc.values...), tc.workers, double) {
+				if r.IsErr() {
+					errs++
+					continue
+				}
+				oks = append(oks, r.Unwrap())
+			}
+			sort.Ints(oks)
+			if !reflect.DeepEqual(oks, tc.expectedOks) || errs != tc.expectedErrs {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package result
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	tagOk  byte = 0
+	tagErr byte = 1
+)
+
+var errMalformed = errors.New("result: malformed encoding")
+
+// GobEncode implements the gob.GobEncoder interface. Errors with a
+// code registered by RegisterErr or RegisterErrType keep their identity,
+// while other errors are decoded as errors with the same message.
+func (r Result[T]) GobEncode() ([]byte, error) {
+	if r.IsErr() {
+		return appendErr([]byte{tagErr}, r.err), nil
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(tagOk)
+	if err := gob.NewEncoder(&buf).Encode(&r.data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (r *Result[T]) GobDecode(data []byte) error {
+	return r.decode(data, func(b []byte) (T, error) {
+		var t T
+		err := gob.NewDecoder(bytes.NewReader(b)).Decode(&t)
+		return t, err
+	})
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// It returns an error if the result is `Ok` and T does not implement
+// encoding.BinaryMarshaler.
+func (r Result[T]) MarshalBinary() ([]byte, error) {
+	if r.IsErr() {
+		return appendErr([]byte{tagErr}, r.err), nil
+	}
+	m, ok := any(r.data).(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("result: %T does not implement encoding.BinaryMarshaler", r.data)
+	}
+	data, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{tagOk}, data...), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It returns an error if the data is `Ok` and *T does not implement
+// encoding.BinaryUnmarshaler.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	return r.decode(data, func(b []byte) (T, error) {
+		var t T
+		u, ok := any(&t).(encoding.BinaryUnmarshaler)
+		if !ok {
+			return t, fmt.Errorf("result: %T does not implement encoding.BinaryUnmarshaler", &t)
+		}
+		err := u.UnmarshalBinary(b)
+		return t, err
+	})
+}
+
+// MarshalText implements the encoding.TextMarshaler interface. An `Ok`
+// result is written as "ok:" followed by the text of the value, and an
+// `Err` result as "err:" followed by the error message. Errors with a
+// registered code are written as "err[code]:message", or with their
+// fields as "err[code;fields]:message" where the fields are URL query
+// encoded. It returns an error if the result is `Ok` and T does not
+// implement encoding.TextMarshaler.
+func (r Result[T]) MarshalText() ([]byte, error) {
+	if r.IsErr() {
+		return []byte(marshalErrText(r.err)), nil
+	}
+	m, ok := any(r.data).(encoding.TextMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("result: %T does not implement encoding.TextMarshaler", r.data)
+	}
+	text, err := m.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte("ok:"), text...), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// It returns an error if the text is `Ok` and *T does not implement
+// encoding.TextUnmarshaler.
+func (r *Result[T]) UnmarshalText(text []byte) error {
+	s := string(text)
+	if !strings.HasPrefix(s, "ok:") {
+		err, decErr := unmarshalErrText(s)
+		if decErr != nil {
+			return decErr
+		}
+		*r = Err[T](err)
+		return nil
+	}
+	var t T
+	u, ok := any(&t).(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("result: %T does not implement encoding.TextUnmarshaler", &t)
+	}
+	if err := u.UnmarshalText([]byte(s[len("ok:"):])); err != nil {
+		return err
+	}
+	*r = Ok(t)
+	return nil
+}
+
+// jsonErr is the JSON form of an error.
+type jsonErr struct {
+	Err    string            `json:"err"`
+	Code   string            `json:"code,omitempty"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface. An `Ok` result
+// is written as {"ok":value} with the value encoded by encoding/json,
+// and an `Err` result as {"err":message}, along with the code and
+// fields of errors registered by RegisterErr or RegisterErrType. It
+// takes precedence over MarshalText, so results of any type can be
+// encoded as JSON.
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.IsErr() {
+		code, fields := encodeError(r.err)
+		return json.Marshal(jsonErr{Err: r.err.Error(), Code: code, Fields: fields})
+	}
+	return json.Marshal(struct {
+		Ok T `json:"ok"`
+	}{r.data})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, reading
+// the form written by MarshalJSON. By the convention of encoding/json,
+// null leaves the result unchanged.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return err
+	}
+	if raw, ok := obj["ok"]; ok {
+		var t T
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return err
+		}
+		*r = Ok(t)
+		return nil
+	}
+	if _, ok := obj["err"]; !ok {
+		return errMalformed
+	}
+	var je jsonErr
+	if err := json.Unmarshal(data, &je); err != nil {
+		return err
+	}
+	if je.Code != "" && !validCode(je.Code) {
+		return errMalformed
+	}
+	err, decErr := decodeError(je.Code, je.Fields, je.Err)
+	if decErr != nil {
+		return decErr
+	}
+	*r = Err[T](err)
+	return nil
+}
+
+// decode reads the binary form shared by GobDecode and UnmarshalBinary,
+// using `decodeData` to read the value of an `Ok` result.
+func (r *Result[T]) decode(data []byte, decodeData func([]byte) (T, error)) error {
+	if len(data) == 0 {
+		return errMalformed
+	}
+	switch data[0] {
+	case tagOk:
+		t, err := decodeData(data[1:])
+		if err != nil {
+			return err
+		}
+		*r = Ok(t)
+	case tagErr:
+		err, decErr := readErr(data[1:])
+		if decErr != nil {
+			return decErr
+		}
+		*r = Err[T](err)
+	default:
+		return errMalformed
+	}
+	return nil
+}
+
+// appendErr appends the registered code, fields, and message of the
+// error. Strings are prefixed by their length, and fields by their count.
+func appendErr(buf []byte, err error) []byte {
+	code, fields := encodeError(err)
+	buf = appendString(buf, code)
+	buf = binary.AppendUvarint(buf, uint64(len(fields)))
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		buf = appendString(buf, key)
+		buf = appendString(buf, fields[key])
+	}
+	return appendString(buf, err.Error())
+}
+
+func readErr(data []byte) (error, error) {
+	code, data, ok := readString(data)
+	if !ok {
+		return nil, errMalformed
+	}
+	n, size := binary.Uvarint(data)
+	if size <= 0 || n > uint64(len(data)) {
+		return nil, errMalformed
+	}
+	data = data[size:]
+	var fields map[string]string
+	if n > 0 {
+		fields = make(map[string]string, n)
+	}
+	for i := uint64(0); i < n; i++ {
+		var key, value string
+		if key, data, ok = readString(data); !ok {
+			return nil, errMalformed
+		}
+		if value, data, ok = readString(data); !ok {
+			return nil, errMalformed
+		}
+		fields[key] = value
+	}
+	msg, data, ok := readString(data)
+	if !ok || len(data) != 0 {
+		return nil, errMalformed
+	}
+	return decodeError(code, fields, msg)
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(s)))
+	return append(buf, s...)
+}
+
+func readString(data []byte) (string, []byte, bool) {
+	n, size := binary.Uvarint(data)
+	if size <= 0 || uint64(len(data)-size) < n {
+		return "", nil, false
+	}
+	data = data[size:]
+	return string(data[:n]), data[n:], true
+}
+
+// marshalErrText returns the text form of an error used by MarshalText.
+func marshalErrText(err error) string {
+	code, fields := encodeError(err)
+	if code == "" {
+		return "err:" + err.Error()
+	}
+	if len(fields) == 0 {
+		return "err[" + code + "]:" + err.Error()
+	}
+	values := url.Values{}
+	for key, value := range fields {
+		values.Set(key, value)
+	}
+	return "err[" + code + ";" + values.Encode() + "]:" + err.Error()
+}
+
+// unmarshalErrText reconstructs an error from the text form written
+// by marshalErrText.
+func unmarshalErrText(s string) (error, error) {
+	if strings.HasPrefix(s, "err:") {
+		return errors.New(s[len("err:"):]), nil
+	}
+	if !strings.HasPrefix(s, "err[") {
+		return nil, errMalformed
+	}
+	header, msg, ok := strings.Cut(s[len("err["):], "]:")
+	if !ok {
+		return nil, errMalformed
+	}
+	code, query, hasFields := strings.Cut(header, ";")
+	if !validCode(code) {
+		return nil, errMalformed
+	}
+	var fields map[string]string
+	if hasFields {
+		values, err := url.ParseQuery(query)
+		if err != nil {
+			return nil, errMalformed
+		}
+		fields = make(map[string]string, len(values))
+		for key := range values {
+			fields[key] = values.Get(key)
+		}
+	}
+	return decodeError(code, fields, msg)
+}
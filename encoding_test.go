@@ -0,0 +1,359 @@
+package result_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+type codeError struct {
+	Code int
+}
+
+func (e *codeError) Error() string {
+	return "code " + strconv.Itoa(e.Code)
+}
+
+var errGone = errors.New("gone")
+
+func init() {
+	result.RegisterErrType("test/code_error",
+		func(e *codeError) map[string]string {
+			return map[string]string{"code": strconv.Itoa(e.Code)}
+		},
+		func(fields map[string]string) (*codeError, error) {
+			code, err := strconv.Atoi(fields["code"])
+			return &codeError{Code: code}, err
+		},
+	)
+	result.RegisterErr("test/gone", errGone)
+}
+
+func hasCode(r result.Result[netip.Addr], code int) bool {
+	return r.IsErrAnd(func(err error) bool {
+		var ce *codeError
+		return errors.As(err, &ce) && ce.Code == code
+	})
+}
+
+func TestGob(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[string]
+		typedErr bool
+	}{
+		"success": {
+			value: result.Ok("hello"),
+		},
+		"success_zero": {
+			value: result.Ok(""),
+		},
+		"error": {
+			value: result.Err[string](errors.New("failed")),
+		},
+		"typed_error": {
+			value:    result.Err[string](&codeError{Code: 7}),
+			typedErr: true,
+		},
+		"sentinel": {
+			value: result.Err[string](fmt.Errorf("user 1: %w", errGone)),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tc.value); err != nil {
+				t.FailNow()
+			}
+			var decoded result.Result[string]
+			if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+				t.FailNow()
+			}
+			if !result.Equal(decoded, tc.value) {
+				t.Fail()
+			}
+			var ce *codeError
+			if decoded.IsErrAnd(func(err error) bool { return errors.As(err, &ce) }) != tc.typedErr {
+				t.Fail()
+			}
+			if decoded.ContainsErr(errGone) != tc.value.ContainsErr(errGone) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBinary(t *testing.T) {
+	tests := map[string]struct {
+		value result.Result[netip.Addr]
+		code  int
+	}{
+		"success": {
+			value: result.Ok(netip.MustParseAddr("10.0.0.1")),
+		},
+		"error": {
+			value: result.Err[netip.Addr](errors.New("failed")),
+		},
+		"typed_error": {
+			value: result.Err[netip.Addr](&codeError{Code: 404}),
+			code:  404,
+		},
+		"wrapped_typed_error": {
+			value: result.Err[netip.Addr](fmt.Errorf("lookup: %w", &codeError{Code: 404})),
+			code:  404,
+		},
+		"sentinel": {
+			value: result.Err[netip.Addr](errGone),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			data, err := tc.value.MarshalBinary()
+			if err != nil {
+				t.FailNow()
+			}
+			var decoded result.Result[netip.Addr]
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.FailNow()
+			}
+			if !result.Equal(decoded, tc.value) {
+				t.Fail()
+			}
+			if tc.code != 0 && !hasCode(decoded, tc.code) {
+				t.Fail()
+			}
+			if decoded.ContainsErr(errGone) != tc.value.ContainsErr(errGone) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestBinaryUnsupported(t *testing.T) {
+	if _, err := result.Ok(1).MarshalBinary(); err == nil {
+		t.Fail()
+	}
+	var r result.Result[int]
+	if err := r.UnmarshalBinary([]byte{0, 1}); err == nil {
+		t.Fail()
+	}
+	if err := r.UnmarshalBinary(nil); err == nil {
+		t.Fail()
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[netip.Addr]
+		expected string
+		code     int
+	}{
+		"success": {
+			value:    result.Ok(netip.MustParseAddr("10.0.0.1")),
+			expected: "ok:10.0.0.1",
+		},
+		"error": {
+			value:    result.Err[netip.Addr](errors.New("failed: bad]:input")),
+			expected: "err:failed: bad]:input",
+		},
+		"typed_error": {
+			value:    result.Err[netip.Addr](&codeError{Code: 500}),
+			expected: "err[test/code_error;code=500]:code 500",
+			code:     500,
+		},
+		"sentinel": {
+			value:    result.Err[netip.Addr](fmt.Errorf("user 1: %w", errGone)),
+			expected: "err[test/gone]:user 1: gone",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			text, err := tc.value.MarshalText()
+			if err != nil || string(text) != tc.expected {
+				t.FailNow()
+			}
+			var decoded result.Result[netip.Addr]
+			if err := decoded.UnmarshalText(text); err != nil {
+				t.FailNow()
+			}
+			if !result.Equal(decoded, tc.value) {
+				t.Fail()
+			}
+			if tc.code != 0 && !hasCode(decoded, tc.code) {
+				t.Fail()
+			}
+			if decoded.ContainsErr(errGone) != tc.value.ContainsErr(errGone) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTextMalformed(t *testing.T) {
+	tests := map[string]struct {
+		text string
+	}{
+		"empty":          {text: ""},
+		"unknown_prefix": {text: "maybe:1"},
+		"bad_header":     {text: "err[test/gone:x"},
+		"bad_code":       {text: "err[bad code]:x"},
+		"bad_fields":     {text: "err[test/code_error;code=%zz]:x"},
+		"bad_field":      {text: "err[test/code_error;code=abc]:x"},
+		"bad_value":      {text: "ok:not-an-ip"},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var r result.Result[netip.Addr]
+			if err := r.UnmarshalText([]byte(tc.text)); err == nil {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		expected string
+		code     int
+	}{
+		"success": {
+			value:    result.Ok(1),
+			expected: `{"ok":1}`,
+		},
+		"zero": {
+			value:    result.Ok(0),
+			expected: `{"ok":0}`,
+		},
+		"error": {
+			value:    result.Err[int](errors.New("failed")),
+			expected: `{"err":"failed"}`,
+		},
+		"typed_error": {
+			value:    result.Err[int](&codeError{Code: 500}),
+			expected: `{"err":"code 500","code":"test/code_error","fields":{"code":"500"}}`,
+			code:     500,
+		},
+		"sentinel": {
+			value:    result.Err[int](fmt.Errorf("user 1: %w", errGone)),
+			expected: `{"err":"user 1: gone","code":"test/gone"}`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			data, err := json.Marshal(tc.value)
+			if err != nil || string(data) != tc.expected {
+				t.FailNow()
+			}
+			var decoded result.Result[int]
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.FailNow()
+			}
+			if !result.Equal(decoded, tc.value) {
+				t.Fail()
+			}
+			if tc.code != 0 && !decoded.IsErrAnd(func(err error) bool {
+				var ce *codeError
+				return errors.As(err, &ce) && ce.Code == tc.code
+			}) {
+				t.Fail()
+			}
+			if decoded.ContainsErr(errGone) != tc.value.ContainsErr(errGone) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestJSONStruct(t *testing.T) {
+	type response struct {
+		R result.Result[int]
+	}
+	tests := map[string]struct {
+		value    response
+		expected string
+	}{
+		"success": {
+			value:    response{R: result.Ok(1)},
+			expected: `{"R":{"ok":1}}`,
+		},
+		"error": {
+			value:    response{R: result.Err[int](errors.New("failed"))},
+			expected: `{"R":{"err":"failed"}}`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			data, err := json.Marshal(tc.value)
+			if err != nil || string(data) != tc.expected {
+				t.FailNow()
+			}
+			var decoded response
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.FailNow()
+			}
+			if !result.Equal(decoded.R, tc.value.R) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestJSONNull(t *testing.T) {
+	type response struct {
+		R result.Result[int]
+	}
+	tests := map[string]struct {
+		value response
+	}{
+		"zero":    {value: response{}},
+		"success": {value: response{R: result.Ok(1)}},
+		"error":   {value: response{R: result.Err[int](errors.New("failed"))}},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			decoded := tc.value
+			if err := json.Unmarshal([]byte(`{"R":null}`), &decoded); err != nil {
+				t.FailNow()
+			}
+			if !decoded.R.Equal(tc.value.R) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestJSONMalformed(t *testing.T) {
+	tests := map[string]struct {
+		data string
+	}{
+		"not_object": {data: `1`},
+		"empty":      {data: `{}`},
+		"bad_value":  {data: `{"ok":"one"}`},
+		"bad_err":    {data: `{"err":1}`},
+		"bad_code":   {data: `{"err":"x","code":"bad code"}`},
+		"bad_field":  {data: `{"err":"x","code":"test/code_error","fields":{"code":"abc"}}`},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var r result.Result[int]
+			if err := json.Unmarshal([]byte(tc.data), &r); err == nil {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package result
+
+// Filter returns the result if it is `Err` or its `Ok` value satisfies
+// the predicate, and otherwise an `Err` with the error returned by
+// `errFn` for the value.
+func (r Result[T]) Filter(predicate func(T) bool, errFn func(T) error) Result[T] {
+	if r.IsErr() || predicate(r.data) {
+		return r
+	}
+	return Err[T](errFn(r.data))
+}
+
+// Ensure returns the result if it is `Err` or its `Ok` value satisfies
+// the predicate, and otherwise an `Err` with the given error.
+func (r Result[T]) Ensure(predicate func(T) bool, err error) Result[T] {
+	if r.IsErr() || predicate(r.data) {
+		return r
+	}
+	return Err[T](err)
+}
+
+// Check is a predicate paired with the error to report when a value
+// does not satisfy it.
+type Check[T any] struct {
+	Predicate func(T) bool
+	Err       error
+}
+
+// EnsureAll applies the checks to the `Ok` value in order, returning an
+// `Err` with the error of the first check it does not satisfy. The
+// result is returned unchanged if it is `Err` or satisfies every check.
+func (r Result[T]) EnsureAll(checks ...Check[T]) Result[T] {
+	for _, c := range checks {
+		r = r.Ensure(c.Predicate, c.Err)
+	}
+	return r
+}
@@ -0,0 +1,112 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+var (
+	errNegative = errors.New("negative")
+	errOdd      = errors.New("odd")
+)
+
+func nonNegative(x int) bool { return x >= 0 }
+func even(x int) bool        { return x%2 == 0 }
+
+func TestFilter(t *testing.T) {
+	tests := map[string]struct {
+		result   result.Result[int]
+		expected result.Result[int]
+	}{
+		"ok passes": {
+			result:   result.Ok(2),
+			expected: result.Ok(2),
+		},
+		"ok fails": {
+			result:   result.Ok(-2),
+			expected: result.Err[int](errors.New("-2 is negative")),
+		},
+		"err": {
+			result:   result.Err[int](errors.New("failed")),
+			expected: result.Err[int](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := tc.result.Filter(nonNegative, func(x int) error {
+				return fmt.Errorf("%d is negative", x)
+			})
+			if !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestEnsure(t *testing.T) {
+	tests := map[string]struct {
+		result   result.Result[int]
+		expected result.Result[int]
+	}{
+		"ok passes": {
+			result:   result.Ok(2),
+			expected: result.Ok(2),
+		},
+		"ok fails": {
+			result:   result.Ok(-2),
+			expected: result.Err[int](errNegative),
+		},
+		"err": {
+			result:   result.Err[int](errors.New("failed")),
+			expected: result.Err[int](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := tc.result.Ensure(nonNegative, errNegative); !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestEnsureAll(t *testing.T) {
+	checks := []result.Check[int]{
+		{Predicate: nonNegative, Err: errNegative},
+		{Predicate: even, Err: errOdd},
+	}
+	tests := map[string]struct {
+		result   result.Result[int]
+		expected result.Result[int]
+	}{
+		"ok passes all": {
+			result:   result.Ok(2),
+			expected: result.Ok(2),
+		},
+		"ok fails first": {
+			result:   result.Ok(-3),
+			expected: result.Err[int](errNegative),
+		},
+		"ok fails second": {
+			result:   result.Ok(3),
+			expected: result.Err[int](errOdd),
+		},
+		"err": {
+			result:   result.Err[int](errors.New("failed")),
+			expected: result.Err[int](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := tc.result.EnsureAll(checks...); !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package result
+
+// Fold combines the elements of the slice in order, starting from
+// `init`. The first `Err` returned by `f` is returned with its error
+// wrapped in a KeyedError holding the index of the element which caused
+// it, without calling `f` on the remaining elements.
+func Fold[T any, U any](ts []T, init U, f func(U, T) Result[U]) Result[U] {
+	return FoldSeq(func(yield func(T) bool) {
+		for _, t := range ts {
+			if !yield(t) {
+				return
+			}
+		}
+	}, init, f)
+}
+
+// FoldSeq combines the elements of the sequence in order, starting from
+// `init`. The first `Err` returned by `f` is returned with its error
+// wrapped in a KeyedError holding the position of the element in the
+// sequence, and the sequence is stopped. Elements yielded after the
+// sequence is stopped are ignored. The sequence has the type of
+// an iter.Seq[T], which can be passed to FoldSeq directly; it is not
+// named so that the package does not require Go 1.23.
+func FoldSeq[T any, U any](seq func(yield func(T) bool), init U, f func(U, T) Result[U]) Result[U] {
+	acc := init
+	i := 0
+	var err error
+	seq(func(t T) bool {
+		if err != nil {
+			return false
+		}
+		r := f(acc, t)
+		if r.IsErr() {
+			err = KeyedError{Key: i, Err: r.err}
+			return false
+		}
+		acc = r.data
+		i++
+		return true
+	})
+	if err != nil {
+		return Err[U](err)
+	}
+	return Ok(acc)
+}
@@ -0,0 +1,108 @@
+package result_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func sumAtoi(acc int, s string) result.Result[int] {
+	return result.Map(atoi(s), func(x int) int { return acc + x })
+}
+
+func TestFold(t *testing.T) {
+	tests := map[string]struct {
+		input    []string
+		expected result.Result[int]
+	}{
+		"empty": {
+			input:    nil,
+			expected: result.Ok(10),
+		},
+		"all ok": {
+			input:    []string{"1", "2", "3"},
+			expected: result.Ok(16),
+		},
+		"err reports index": {
+			input:    []string{"1", "x", "y"},
+			expected: result.Err[int](errors.New(`[1]: strconv.Atoi: parsing "x": invalid syntax`)),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.Fold(tc.input, 10, sumAtoi)
+			if !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+			if got.IsErr() && !errors.Is(got.UnwrapErr(), strconv.ErrSyntax) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestFoldSeq(t *testing.T) {
+	tests := map[string]struct {
+		input    []string
+		expected result.Result[int]
+		consumed int
+	}{
+		"empty": {
+			input:    nil,
+			expected: result.Ok(0),
+		},
+		"all ok": {
+			input:    []string{"1", "2", "3"},
+			expected: result.Ok(6),
+			consumed: 3,
+		},
+		"stops at err": {
+			input:    []string{"1", "2", "x", "4"},
+			expected: result.Err[int](errors.New(`[2]: strconv.Atoi: parsing "x": invalid syntax`)),
+			consumed: 3,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			consumed := 0
+			seq := func(yield func(string) bool) {
+				for _, s := range tc.input {
+					consumed++
+					if !yield(s) {
+						return
+					}
+				}
+			}
+			got := result.FoldSeq(seq, 0, sumAtoi)
+			if !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+			if consumed != tc.consumed {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestFoldSeqIgnoresYieldAfterStop(t *testing.T) {
+	calls := 0
+	seq := func(yield func(string) bool) {
+		for _, s := range []string{"1", "x", "2", "y"} {
+			yield(s)
+		}
+	}
+	got := result.FoldSeq(seq, 0, func(acc int, s string) result.Result[int] {
+		calls++
+		return sumAtoi(acc, s)
+	})
+	if !result.Equal(got, result.Err[int](errors.New(`[1]: strconv.Atoi: parsing "x": invalid syntax`))) {
+		t.Fail()
+	}
+	if calls != 2 {
+		t.Fail()
+	}
+}
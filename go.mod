@@ -1,3 +1,3 @@
 module github.com/JustinKnueppel/go-result
 
-go 1.19
+go 1.22
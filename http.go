@@ -0,0 +1,125 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Response is the success value written by a handler created with
+// HandlerFunc. A zero Status is written as 200 OK.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   []byte
+}
+
+// Problem is the RFC 9457 problem details object written for `Err`
+// results by a handler created with HandlerFunc.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// StatusRule maps errors matching a condition to an HTTP status code.
+// Rules are created with StatusIs and StatusAs; the zero value matches
+// no errors.
+type StatusRule struct {
+	match  func(error) bool
+	status int
+}
+
+// StatusIs returns a StatusRule which maps errors matching
+// `target` according to errors.Is to `status`. It panics if
+// `status` is not a valid HTTP status code.
+func StatusIs(target error, status int) StatusRule {
+	checkStatus(status)
+	return StatusRule{
+		match:  func(err error) bool { return errors.Is(err, target) },
+		status: status,
+	}
+}
+
+// StatusAs returns a StatusRule which maps errors containing an
+// error of type E according to errors.As to `status`. It panics
+// if `status` is not a valid HTTP status code.
+func StatusAs[E error](status int) StatusRule {
+	checkStatus(status)
+	return StatusRule{
+		match: func(err error) bool {
+			var e E
+			return errors.As(err, &e)
+		},
+		status: status,
+	}
+}
+
+// checkStatus panics if the status is not a valid HTTP status code,
+// which http.ResponseWriter.WriteHeader would panic on when the rule
+// is used.
+func checkStatus(status int) {
+	if status < 100 || status > 599 {
+		panic(fmt.Sprintf("result: invalid HTTP status %d", status))
+	}
+}
+
+// HandlerFunc returns an http.Handler which writes the `Ok` response
+// returned by `f`, or writes an `Err` as problem details JSON. The
+// status of an `Err` is taken from the first matching rule, or else
+// from the defined error it matches, if any. Other errors are written
+// as 500 Internal Server Error without their message, so internal
+// details are not exposed to clients.
+func HandlerFunc(f func(*http.Request) Result[Response], rules ...StatusRule) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		f(req).Inspect(func(resp Response) {
+			writeResponse(w, resp)
+		}).InspectErr(func(err error) {
+			writeProblem(w, req, err, rules)
+		})
+	})
+}
+
+func writeResponse(w http.ResponseWriter, resp Response) {
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	status := resp.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	w.Write(resp.Body)
+}
+
+func writeProblem(w http.ResponseWriter, req *http.Request, err error, rules []StatusRule) {
+	problem := Problem{
+		Type:     "about:blank",
+		Status:   http.StatusInternalServerError,
+		Instance: req.URL.Path,
+	}
+	matched := false
+	for _, rule := range rules {
+		if rule.match != nil && rule.match(err) {
+			problem.Status = rule.status
+			problem.Detail = err.Error()
+			matched = true
+			break
+		}
+	}
+	if d, ok := DefinitionOf(err); ok && !matched {
+		problem.Status = d.status
+		problem.Detail = err.Error()
+	}
+	problem.Title = http.StatusText(problem.Status)
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
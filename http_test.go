@@ -0,0 +1,174 @@
+package result_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func TestHandlerFuncOk(t *testing.T) {
+	tests := map[string]struct {
+		response       result.Response
+		expectedStatus int
+		expectedHeader string
+		expectedBody   string
+	}{
+		"default_status": {
+			response:       result.Response{Body: []byte("hello")},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "hello",
+		},
+		"custom_status_and_header": {
+			response: result.Response{
+				Status: http.StatusCreated,
+				Header: http.Header{"Content-Type": {"text/plain"}},
+				Body:   []byte("created"),
+			},
+			expectedStatus: http.StatusCreated,
+			expectedHeader: "text/plain",
+			expectedBody:   "created",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			h := result.HandlerFunc(func(*http.Request) result.Result[result.Response] {
+				return result.Ok(tc.response)
+			})
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+			if rec.Code != tc.expectedStatus {
+				t.Fail()
+			}
+			if rec.Header().Get("Content-Type") != tc.expectedHeader && tc.expectedHeader != "" {
+				t.Fail()
+			}
+			if rec.Body.String() != tc.expectedBody {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestHandlerFuncErr(t *testing.T) {
+	errNotFound := errors.New("not found")
+	errForbidden := errors.New("forbidden")
+	rules := []result.StatusRule{
+		{},
+		result.StatusIs(errNotFound, http.StatusNotFound),
+		result.StatusAs[*result.NoRowsError](http.StatusNotFound),
+		result.StatusIs(errForbidden, http.StatusForbidden),
+	}
+	tests := map[string]struct {
+		err      error
+		expected result.Problem
+	}{
+		"is": {
+			err: fmt.Errorf("user 1: %w", errNotFound),
+			expected: result.Problem{
+				Type:     "about:blank",
+				Title:    "Not Found",
+				Status:   http.StatusNotFound,
+				Detail:   "user 1: not found",
+				Instance: "/users/1",
+			},
+		},
+		"as": {
+			err: &result.NoRowsError{Query: "q"},
+			expected: result.Problem{
+				Type:     "about:blank",
+				Title:    "Not Found",
+				Status:   http.StatusNotFound,
+				Detail:   `result: no rows for query "q"`,
+				Instance: "/users/1",
+			},
+		},
+		"second_rule": {
+			err: errForbidden,
+			expected: result.Problem{
+				Type:     "about:blank",
+				Title:    "Forbidden",
+				Status:   http.StatusForbidden,
+				Detail:   "forbidden",
+				Instance: "/users/1",
+			},
+		},
+		"defined": {
+			err: errResourceGone.New("user", 1),
+			expected: result.Problem{
+				Type:     "about:blank",
+				Title:    "Gone",
+				Status:   http.StatusGone,
+				Detail:   "resource gone: user 1",
+				Instance: "/users/1",
+			},
+		},
+		"rule_before_defined": {
+			err: fmt.Errorf("%w: %w", errResourceGone, errForbidden),
+			expected: result.Problem{
+				Type:     "about:blank",
+				Title:    "Forbidden",
+				Status:   http.StatusForbidden,
+				Detail:   "resource gone: forbidden",
+				Instance: "/users/1",
+			},
+		},
+		"unmapped": {
+			err: errors.New("database password is hunter2"),
+			expected: result.Problem{
+				Type:     "about:blank",
+				Title:    "Internal Server Error",
+				Status:   http.StatusInternalServerError,
+				Instance: "/users/1",
+			},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			h := result.HandlerFunc(func(*http.Request) result.Result[result.Response] {
+				return result.Err[result.Response](tc.err)
+			}, rules...)
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+			if rec.Code != tc.expected.Status {
+				t.Fail()
+			}
+			if rec.Header().Get("Content-Type") != "application/problem+json" {
+				t.Fail()
+			}
+			var problem result.Problem
+			if err := json.NewDecoder(rec.Body).Decode(&problem); err != nil {
+				t.FailNow()
+			}
+			if problem != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestStatusRuleInvalidStatus(t *testing.T) {
+	tests := map[string]func(){
+		"is_zero":  func() { result.StatusIs(errors.New("error"), 0) },
+		"is_large": func() { result.StatusIs(errors.New("error"), 600) },
+		"as_zero":  func() { result.StatusAs[*result.NoRowsError](0) },
+		"as_small": func() { result.StatusAs[*result.NoRowsError](99) },
+	}
+
+	for tname, f := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+			f()
+		})
+	}
+}
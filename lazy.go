@@ -0,0 +1,76 @@
+package result
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy is a result which is computed by a function on first access and
+// then cached. It is safe for concurrent use, and the function is called
+// by one goroutine at a time, with other callers waiting for its result.
+type Lazy[T any] struct {
+	mu     sync.Mutex
+	done   atomic.Bool
+	f      func() Result[T]
+	retry  func(error) bool
+	result Result[T]
+}
+
+// NewLazy returns a Lazy which computes its result with `f`. The result
+// is cached whether it is `Ok` or `Err`.
+func NewLazy[T any](f func() Result[T]) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// NewRetryLazy returns a Lazy which computes its result with `f`. An
+// `Err` result for which `retry` returns `true` is not cached, so `f` is
+// called again on the next access.
+func NewRetryLazy[T any](f func() Result[T], retry func(error) bool) *Lazy[T] {
+	return &Lazy[T]{f: f, retry: retry}
+}
+
+// Get returns the result, computing it if it has not been cached yet.
+func (l *Lazy[T]) Get() Result[T] {
+	if l.done.Load() {
+		return l.result
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.done.Load() {
+		return l.result
+	}
+	r := l.f()
+	if r.IsErr() && l.retry != nil && l.retry(r.err) {
+		return r
+	}
+	l.result = r
+	l.f = nil
+	l.done.Store(true)
+	return r
+}
+
+// IsEvaluated returns `true` if the result has been computed and cached.
+func (l *Lazy[T]) IsEvaluated() bool {
+	return l.done.Load()
+}
+
+// LazyMap returns a Lazy which applies `f` to the `Ok` value of `l` once
+// it is accessed, leaving an `Err` value untouched. Errors which `l` does
+// not cache are not cached by the returned Lazy either.
+func LazyMap[T any, U any](l *Lazy[T], f func(T) U) *Lazy[U] {
+	return LazyAndThen(l, func(t T) Result[U] {
+		return Ok(f(t))
+	})
+}
+
+// LazyAndThen returns a Lazy which calls `f` with the `Ok` value of `l`
+// once it is accessed, or returns the `Err` value of `l`. Errors which
+// `l` does not cache are not cached by the returned Lazy either, and
+// errors returned by `f` are retried according to the policy of `l`.
+func LazyAndThen[T any, U any](l *Lazy[T], f func(T) Result[U]) *Lazy[U] {
+	return NewRetryLazy(func() Result[U] {
+		return AndThen(l.Get(), f)
+	}, func(err error) bool {
+		return !l.IsEvaluated() || (l.retry != nil && l.retry(err))
+	})
+}
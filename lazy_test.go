@@ -0,0 +1,204 @@
+package result_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+var errTransient = errors.New("transient")
+
+func isTransient(err error) bool {
+	return errors.Is(err, errTransient)
+}
+
+// counted returns a function which returns the results in order, one per
+// call, and a counter of how many times it was called.
+func counted[T any](results ...result.Result[T]) (func() result.Result[T], *atomic.Int32) {
+	var calls atomic.Int32
+	return func() result.Result[T] {
+		n := calls.Add(1)
+		return results[int(n-1)%len(results)]
+	}, &calls
+}
+
+func TestLazyGet(t *testing.T) {
+	tests := map[string]struct {
+		results  []result.Result[int]
+		retry    func(error) bool
+		gets     int
+		expected result.Result[int]
+		calls    int32
+	}{
+		"ok is cached": {
+			results:  []result.Result[int]{result.Ok(1), result.Ok(2)},
+			gets:     3,
+			expected: result.Ok(1),
+			calls:    1,
+		},
+		"err is cached without retry": {
+			results:  []result.Result[int]{result.Err[int](errTransient), result.Ok(2)},
+			gets:     3,
+			expected: result.Err[int](errTransient),
+			calls:    1,
+		},
+		"retried err is not cached": {
+			results:  []result.Result[int]{result.Err[int](errTransient), result.Ok(2)},
+			retry:    isTransient,
+			gets:     3,
+			expected: result.Ok(2),
+			calls:    2,
+		},
+		"other err is cached with retry": {
+			results:  []result.Result[int]{result.Err[int](errors.New("fatal")), result.Ok(2)},
+			retry:    isTransient,
+			gets:     3,
+			expected: result.Err[int](errors.New("fatal")),
+			calls:    1,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			f, calls := counted(tc.results...)
+			var l *result.Lazy[int]
+			if tc.retry == nil {
+				l = result.NewLazy(f)
+			} else {
+				l = result.NewRetryLazy(f, tc.retry)
+			}
+			if l.IsEvaluated() || calls.Load() != 0 {
+				t.FailNow()
+			}
+			var got result.Result[int]
+			for i := 0; i < tc.gets; i++ {
+				got = l.Get()
+			}
+			if !got.Equal(tc.expected) {
+				t.Fail()
+			}
+			if calls.Load() != tc.calls {
+				t.Fail()
+			}
+			if !l.IsEvaluated() {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestLazyGetConcurrent(t *testing.T) {
+	f, calls := counted(result.Ok(1))
+	l := result.NewLazy(f)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l.Get(); !got.Equal(result.Ok(1)) {
+				t.Fail()
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fail()
+	}
+}
+
+func TestLazyMap(t *testing.T) {
+	tests := map[string]struct {
+		results  []result.Result[int]
+		retry    func(error) bool
+		expected []result.Result[int]
+	}{
+		"ok": {
+			results:  []result.Result[int]{result.Ok(1)},
+			expected: []result.Result[int]{result.Ok(2), result.Ok(2)},
+		},
+		"cached err": {
+			results:  []result.Result[int]{result.Err[int](errTransient), result.Ok(1)},
+			expected: []result.Result[int]{result.Err[int](errTransient), result.Err[int](errTransient)},
+		},
+		"retried err": {
+			results:  []result.Result[int]{result.Err[int](errTransient), result.Ok(1)},
+			retry:    isTransient,
+			expected: []result.Result[int]{result.Err[int](errTransient), result.Ok(2)},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			f, calls := counted(tc.results...)
+			l := result.NewRetryLazy(f, tc.retry)
+			var mapped atomic.Int32
+			doubled := result.LazyMap(l, func(x int) int {
+				mapped.Add(1)
+				return x * 2
+			})
+			if calls.Load() != 0 || mapped.Load() != 0 {
+				t.FailNow()
+			}
+			for _, expected := range tc.expected {
+				if got := doubled.Get(); !got.Equal(expected) {
+					t.Fail()
+				}
+			}
+			if mapped.Load() > 1 {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestLazyAndThen(t *testing.T) {
+	tests := map[string]struct {
+		source   result.Result[int]
+		fn       []result.Result[string]
+		retry    func(error) bool
+		expected []result.Result[string]
+	}{
+		"ok": {
+			source:   result.Ok(1),
+			fn:       []result.Result[string]{result.Ok("one")},
+			expected: []result.Result[string]{result.Ok("one"), result.Ok("one")},
+		},
+		"source err": {
+			source:   result.Err[int](errors.New("source")),
+			fn:       []result.Result[string]{result.Ok("one")},
+			expected: []result.Result[string]{result.Err[string](errors.New("source"))},
+		},
+		"fn err is cached": {
+			source:   result.Ok(1),
+			fn:       []result.Result[string]{result.Err[string](errTransient), result.Ok("one")},
+			expected: []result.Result[string]{result.Err[string](errTransient), result.Err[string](errTransient)},
+		},
+		"fn err follows source retry policy": {
+			source:   result.Ok(1),
+			fn:       []result.Result[string]{result.Err[string](errTransient), result.Ok("one")},
+			retry:    isTransient,
+			expected: []result.Result[string]{result.Err[string](errTransient), result.Ok("one")},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			source, _ := counted(tc.source)
+			fn, calls := counted(tc.fn...)
+			l := result.LazyAndThen(result.NewRetryLazy(source, tc.retry), func(int) result.Result[string] {
+				return fn()
+			})
+			if calls.Load() != 0 {
+				t.FailNow()
+			}
+			for _, expected := range tc.expected {
+				if got := l.Get(); !got.Equal(expected) {
+					t.Fail()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package result
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyedError is an error associated with the index or key of the element
+// which caused it.
+type KeyedError struct {
+	// Key is the index or key of the element, or nil if there is none.
+	Key any
+	Err error
+}
+
+// Error returns the message of the error, prefixed with the key if
+// there is one.
+func (e KeyedError) Error() string {
+	if e.Key == nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("[%v]: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the error.
+func (e KeyedError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError is a list of errors, each associated with the index or key
+// of the element which caused it. Unlike errors.Join, its message reports
+// which element caused each error. errors.Is and errors.As match any of
+// its errors, and errors.As can extract a KeyedError to find the key.
+type MultiError struct {
+	Errs []KeyedError
+}
+
+// Append adds the error with the given key, which may be nil. A nil
+// error is ignored.
+func (m *MultiError) Append(key any, err error) {
+	if err != nil {
+		m.Errs = append(m.Errs, KeyedError{Key: key, Err: err})
+	}
+}
+
+// Len returns the number of errors.
+func (m *MultiError) Len() int {
+	return len(m.Errs)
+}
+
+// Dedup removes errors which are the same as an earlier error, keeping
+// only the key of the first. Errors are the same if they have the same
+// message and either match each other according to errors.Is or have
+// the same type.
+func (m *MultiError) Dedup() {
+	var kept []KeyedError
+	for _, e := range m.Errs {
+		duplicate := false
+		for _, k := range kept {
+			if sameError(e.Err, k.Err) {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			kept = append(kept, e)
+		}
+	}
+	m.Errs = kept
+}
+
+// ErrOrNil returns the MultiError if it has any errors, and otherwise
+// nil.
+func (m *MultiError) ErrOrNil() error {
+	if m == nil || len(m.Errs) == 0 {
+		return nil
+	}
+	return m
+}
+
+// Error returns a single line for a single error, and otherwise the
+// number of errors followed by one indented line per error.
+func (m *MultiError) Error() string {
+	switch len(m.Errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return m.Errs[0].Error()
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%d errors:", len(m.Errs))
+	for _, e := range m.Errs {
+		sb.WriteString("\n\t")
+		sb.WriteString(strings.ReplaceAll(e.Error(), "\n", "\n\t"))
+	}
+	return sb.String()
+}
+
+// Unwrap returns the errors as KeyedErrors, so that errors.Is and
+// errors.As traverse all of them.
+func (m *MultiError) Unwrap() []error {
+	errs := make([]error, len(m.Errs))
+	for i, e := range m.Errs {
+		errs[i] = e
+	}
+	return errs
+}
+
+// CollectAll returns an `Ok` value with the values of the results if
+// every result is `Ok`, and otherwise an `Err` with a MultiError holding
+// every error keyed by its index.
+func CollectAll[T any](rs []Result[T]) Result[[]T] {
+	if err := JoinErrs(rs); err != nil {
+		return Err[[]T](err)
+	}
+	data := make([]T, len(rs))
+	for i, r := range rs {
+		data[i] = r.data
+	}
+	return Ok(data)
+}
+
+// JoinErrs returns a MultiError holding the error of every `Err` result
+// keyed by its index, or nil if every result is `Ok`.
+func JoinErrs[T any](rs []Result[T]) error {
+	var m MultiError
+	for i, r := range rs {
+		m.Append(i, r.err)
+	}
+	return m.ErrOrNil()
+}
+
+// ErrCount returns the number of `Err` results.
+func ErrCount[T any](rs []Result[T]) int {
+	n := 0
+	for _, r := range rs {
+		if r.IsErr() {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,207 @@
+package result_test
+
+import (
+	"errors"
+	"io/fs"
+	"reflect"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func TestKeyedError(t *testing.T) {
+	tests := map[string]struct {
+		err      result.KeyedError
+		expected string
+	}{
+		"no key": {
+			err:      result.KeyedError{Err: errors.New("failed")},
+			expected: "failed",
+		},
+		"index": {
+			err:      result.KeyedError{Key: 2, Err: errors.New("failed")},
+			expected: "[2]: failed",
+		},
+		"key": {
+			err:      result.KeyedError{Key: "name", Err: errors.New("failed")},
+			expected: "[name]: failed",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := tc.err.Error(); got != tc.expected {
+				t.Fail()
+			}
+			if tc.err.Unwrap() != tc.err.Err {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestMultiErrorError(t *testing.T) {
+	nested := &result.MultiError{}
+	nested.Append("a", errors.New("first"))
+	nested.Append("b", errors.New("second"))
+
+	tests := map[string]struct {
+		errs     []result.KeyedError
+		expected string
+	}{
+		"empty": {
+			expected: "no errors",
+		},
+		"single": {
+			errs:     []result.KeyedError{{Key: 0, Err: errors.New("failed")}},
+			expected: "[0]: failed",
+		},
+		"multiple": {
+			errs: []result.KeyedError{
+				{Key: 0, Err: errors.New("first")},
+				{Key: 3, Err: errors.New("second")},
+			},
+			expected: "2 errors:\n\t[0]: first\n\t[3]: second",
+		},
+		"nested": {
+			errs: []result.KeyedError{
+				{Key: 0, Err: nested},
+				{Err: errors.New("other")},
+			},
+			expected: "2 errors:\n\t[0]: 2 errors:\n\t\t[a]: first\n\t\t[b]: second\n\tother",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			m := &result.MultiError{Errs: tc.errs}
+			if got := m.Error(); got != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestMultiErrorAppend(t *testing.T) {
+	var m result.MultiError
+	m.Append(0, nil)
+	if m.Len() != 0 || m.ErrOrNil() != nil {
+		t.FailNow()
+	}
+	m.Append(1, errors.New("failed"))
+	if m.Len() != 1 || m.ErrOrNil() == nil {
+		t.FailNow()
+	}
+	if !reflect.DeepEqual(m.Errs[0], result.KeyedError{Key: 1, Err: m.Errs[0].Err}) {
+		t.Fail()
+	}
+	var nilMulti *result.MultiError
+	if nilMulti.ErrOrNil() != nil {
+		t.Fail()
+	}
+}
+
+func TestMultiErrorDedup(t *testing.T) {
+	m := &result.MultiError{}
+	m.Append(0, fs.ErrNotExist)
+	m.Append(1, errors.New("failed"))
+	m.Append(2, fs.ErrNotExist)
+	m.Append(3, errors.New("failed"))
+	m.Append(4, errors.New("other"))
+	m.Append(5, errResourceGone.New("user 1"))
+	m.Append(6, errResourceGone.New("user 2"))
+	m.Append(7, errResourceGone.New("user 1"))
+	m.Dedup()
+
+	var keys []any
+	for _, e := range m.Errs {
+		keys = append(keys, e.Key)
+	}
+	if !reflect.DeepEqual(keys, []any{0, 1, 4, 5, 6}) {
+		t.Fail()
+	}
+}
+
+func TestMultiErrorIsAs(t *testing.T) {
+	m := &result.MultiError{}
+	m.Append(0, errors.New("failed"))
+	m.Append(1, &pathError{path: "a"})
+	m.Append(2, fs.ErrPermission)
+	var err error = m
+
+	if !errors.Is(err, fs.ErrPermission) {
+		t.Fail()
+	}
+	if errors.Is(err, fs.ErrNotExist) {
+		t.Fail()
+	}
+	var pe *pathError
+	if !errors.As(err, &pe) || pe.path != "a" {
+		t.Fail()
+	}
+	var keyed result.KeyedError
+	if !errors.As(err, &keyed) || keyed.Key != 0 {
+		t.Fail()
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	tests := map[string]struct {
+		results  []result.Result[int]
+		expected result.Result[[]int]
+	}{
+		"empty": {
+			results:  nil,
+			expected: result.Ok([]int{}),
+		},
+		"all ok": {
+			results:  []result.Result[int]{result.Ok(1), result.Ok(2)},
+			expected: result.Ok([]int{1, 2}),
+		},
+		"errors": {
+			results:  []result.Result[int]{result.Err[int](errors.New("first")), result.Ok(2), result.Err[int](errors.New("third"))},
+			expected: result.Err[[]int](errors.New("2 errors:\n\t[0]: first\n\t[2]: third")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := result.CollectAll(tc.results); !equalSlices(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestJoinErrs(t *testing.T) {
+	if err := result.JoinErrs([]result.Result[int]{result.Ok(1), result.Ok(2)}); err != nil {
+		t.Fail()
+	}
+	err := result.JoinErrs([]result.Result[int]{result.Ok(1), result.Err[int](fs.ErrNotExist)})
+	var m *result.MultiError
+	if !errors.As(err, &m) || m.Len() != 1 || m.Errs[0].Key != 1 {
+		t.Fail()
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		t.Fail()
+	}
+}
+
+func TestErrCount(t *testing.T) {
+	tests := map[string]struct {
+		results  []result.Result[int]
+		expected int
+	}{
+		"empty":    {results: nil, expected: 0},
+		"all ok":   {results: []result.Result[int]{result.Ok(1)}, expected: 0},
+		"some err": {results: []result.Result[int]{result.Err[int](errors.New("a")), result.Ok(1), result.Err[int](errors.New("b"))}, expected: 2},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := result.ErrCount(tc.results); got != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package result
+
+// FromPtr returns an `Ok` value with the value pointed to by `p`, or an
+// `Err` with `errIfNil` if `p` is nil.
+func FromPtr[T any](p *T, errIfNil error) Result[T] {
+	if p == nil {
+		return Err[T](errIfNil)
+	}
+	return Ok(*p)
+}
+
+// ToPtr returns a pointer to a copy of the `Ok` value, or nil if the
+// result is `Err`.
+func (r Result[T]) ToPtr() *T {
+	if r.IsErr() {
+		return nil
+	}
+	data := r.data
+	return &data
+}
+
+// NonNil returns the result unchanged unless it is an `Ok` value holding
+// a nil pointer, which is converted to an `Err` with the given error.
+func NonNil[T any](r Result[*T], err error) Result[*T] {
+	if r.IsOk() && r.data == nil {
+		return Err[*T](err)
+	}
+	return r
+}
@@ -0,0 +1,98 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+var errNil = errors.New("nil")
+
+func TestFromPtr(t *testing.T) {
+	one := 1
+	tests := map[string]struct {
+		ptr      *int
+		expected result.Result[int]
+	}{
+		"non-nil": {
+			ptr:      &one,
+			expected: result.Ok(1),
+		},
+		"nil": {
+			ptr:      nil,
+			expected: result.Err[int](errNil),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := result.FromPtr(tc.ptr, errNil); !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestToPtr(t *testing.T) {
+	tests := map[string]struct {
+		result   result.Result[int]
+		expected *int
+	}{
+		"ok": {
+			result:   result.Ok(1),
+			expected: func() *int { x := 1; return &x }(),
+		},
+		"err": {
+			result:   result.Err[int](errors.New("failed")),
+			expected: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := tc.result.ToPtr()
+			if (got == nil) != (tc.expected == nil) || (got != nil && *got != *tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestToPtrCopies(t *testing.T) {
+	r := result.Ok([1]int{1})
+	p := r.ToPtr()
+	p[0] = 2
+	if r.Unwrap()[0] != 1 {
+		t.Fail()
+	}
+}
+
+func TestNonNil(t *testing.T) {
+	one := 1
+	tests := map[string]struct {
+		result   result.Result[*int]
+		expected result.Result[*int]
+	}{
+		"ok non-nil": {
+			result:   result.Ok(&one),
+			expected: result.Ok(&one),
+		},
+		"ok nil": {
+			result:   result.Ok[*int](nil),
+			expected: result.Err[*int](errNil),
+		},
+		"err": {
+			result:   result.Err[*int](errors.New("failed")),
+			expected: result.Err[*int](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := result.NonNil(tc.result, errNil); !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// registeredErr describes how errors registered under a code are
+// encoded and reconstructed.
+type registeredErr struct {
+	code   string
+	encode func(error) (map[string]string, bool)
+	decode func(map[string]string) (error, error)
+}
+
+var errRegistry = struct {
+	sync.RWMutex
+	entries []registeredErr
+	byCode  map[string]registeredErr
+}{
+	byCode: map[string]registeredErr{},
+}
+
+// RegisterErr registers a sentinel error under a stable code. Errors
+// matching the sentinel according to errors.Is are encoded with the
+// code, and are decoded to errors which match the sentinel again. It
+// panics if the code is invalid or already registered.
+func RegisterErr(code string, sentinel error) {
+	register(registeredErr{
+		code: code,
+		encode: func(err error) (map[string]string, bool) {
+			return nil, errors.Is(err, sentinel)
+		},
+		decode: func(map[string]string) (error, error) {
+			return sentinel, nil
+		},
+	})
+}
+
+// RegisterErrType registers the error type E under a stable code.
+// Errors containing an E according to errors.As are encoded with the
+// code and the fields returned by `encode`, and are decoded to errors
+// containing the E returned by `decode`. It panics if the code is
+// invalid or already registered.
+func RegisterErrType[E error](code string, encode func(E) map[string]string, decode func(map[string]string) (E, error)) {
+	register(registeredErr{
+		code: code,
+		encode: func(err error) (map[string]string, bool) {
+			var e E
+			if !errors.As(err, &e) {
+				return nil, false
+			}
+			return encode(e), true
+		},
+		decode: func(fields map[string]string) (error, error) {
+			return decode(fields)
+		},
+	})
+}
+
+// register adds the entry to the registry. Codes may only contain
+// letters, digits, and the characters "_.-/" so they can be embedded
+// in every encoding.
+func register(entry registeredErr) {
+	if !validCode(entry.code) {
+		panic(fmt.Sprintf("result: invalid error code %q", entry.code))
+	}
+	errRegistry.Lock()
+	defer errRegistry.Unlock()
+	if _, ok := errRegistry.byCode[entry.code]; ok {
+		panic(fmt.Sprintf("result: error code %q already registered", entry.code))
+	}
+	errRegistry.entries = append(errRegistry.entries, entry)
+	errRegistry.byCode[entry.code] = entry
+}
+
+func validCode(code string) bool {
+	if code == "" {
+		return false
+	}
+	for _, c := range code {
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9':
+		case c == '_', c == '.', c == '-', c == '/':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// encodeError returns the code and fields of the first registered
+// entry matching the error, or an empty code if none match. Entries
+// are tried in the order they were registered.
+func encodeError(err error) (string, map[string]string) {
+	errRegistry.RLock()
+	defer errRegistry.RUnlock()
+	for _, entry := range errRegistry.entries {
+		if fields, ok := entry.encode(err); ok {
+			return entry.code, fields
+		}
+	}
+	return "", nil
+}
+
+// decodeError reconstructs an error from its code, fields, and message.
+// Errors with an empty or unregistered code are decoded as an error
+// with the given message. If the reconstructed error has a different
+// message, it is wrapped so the original message is preserved.
+func decodeError(code string, fields map[string]string, msg string) (error, error) {
+	errRegistry.RLock()
+	entry, ok := errRegistry.byCode[code]
+	errRegistry.RUnlock()
+	if !ok {
+		return errors.New(msg), nil
+	}
+	err, decErr := entry.decode(fields)
+	if decErr != nil {
+		return nil, decErr
+	}
+	if err == nil {
+		return errors.New(msg), nil
+	}
+	if err.Error() == msg {
+		return err, nil
+	}
+	return &decodedError{msg: msg, err: err}, nil
+}
+
+// decodedError is a decoded error whose message differs from the
+// message of the registered error it contains.
+type decodedError struct {
+	msg string
+	err error
+}
+
+func (e *decodedError) Error() string {
+	return e.msg
+}
+
+func (e *decodedError) Unwrap() error {
+	return e.err
+}
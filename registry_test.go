@@ -0,0 +1,93 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func TestRegisterErrInvalid(t *testing.T) {
+	tests := map[string]struct {
+		code string
+	}{
+		"empty":      {code: ""},
+		"space":      {code: "not found"},
+		"bracket":    {code: "gone]"},
+		"duplicate":  {code: "test/gone"},
+		"semicolon":  {code: "a;b"},
+		"other_type": {code: "test/code_error"},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Fail()
+				}
+			}()
+			result.RegisterErr(tc.code, errors.New("unused"))
+		})
+	}
+}
+
+func TestRegisteredErrIdentity(t *testing.T) {
+	tests := map[string]struct {
+		err      error
+		sentinel bool
+		code     int
+	}{
+		"sentinel": {
+			err:      errGone,
+			sentinel: true,
+		},
+		"wrapped_sentinel": {
+			err:      fmt.Errorf("item 3: %w", errGone),
+			sentinel: true,
+		},
+		"type": {
+			err:  &codeError{Code: 12},
+			code: 12,
+		},
+		"unregistered": {
+			err: errors.New("plain"),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			data, err := result.Err[netip.Addr](tc.err).MarshalBinary()
+			if err != nil {
+				t.FailNow()
+			}
+			var decoded result.Result[netip.Addr]
+			if err := decoded.UnmarshalBinary(data); err != nil {
+				t.FailNow()
+			}
+			if decoded.UnwrapErr().Error() != tc.err.Error() {
+				t.Fail()
+			}
+			if decoded.ContainsErr(errGone) != tc.sentinel {
+				t.Fail()
+			}
+			if tc.sentinel && tc.err == errGone && decoded.UnwrapErr() != errGone {
+				t.Fail()
+			}
+			if tc.code != 0 && !hasCode(decoded, tc.code) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestUnregisteredCodeDegrades(t *testing.T) {
+	var r result.Result[netip.Addr]
+	if err := r.UnmarshalText([]byte("err[test/unknown;x=1]:remote failure")); err != nil {
+		t.FailNow()
+	}
+	if !result.Equal(r, result.Err[netip.Addr](errors.New("remote failure"))) {
+		t.Fail()
+	}
+}
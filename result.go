@@ -1,6 +1,10 @@
 package result
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 type Result[T any] struct {
 	data T
@@ -24,6 +28,16 @@ func Err[T any](err error) Result[T] {
 	}
 }
 
+// From returns a Result which contains the error value if `err` is not
+// nil, or the success value otherwise. It accepts the results of a
+// function returning (T, error) directly, as in From(strconv.Atoi(s)).
+func From[T any](data T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(data)
+}
+
 // IsOk returns `true` if the result is `Ok`.
 func (r Result[T]) IsOk() bool {
 	return r.err == nil
@@ -52,6 +66,25 @@ func (r Result[T]) IsErrAnd(predicate func(error) bool) bool {
 	return predicate(r.err)
 }
 
+// Ok returns the contained `Ok` value and `true`, or the zero
+// value and `false` if the result is `Err`.
+func (r Result[T]) Ok() (T, bool) {
+	if r.IsErr() {
+		var zero T
+		return zero, false
+	}
+	return r.data, true
+}
+
+// Err returns the contained `Err` value and `true`, or nil and
+// `false` if the result is `Ok`.
+func (r Result[T]) Err() (error, bool) {
+	if r.IsOk() {
+		return nil, false
+	}
+	return r.err, true
+}
+
 // Map maps a Result[T] to Result[U] by applying a function
 // to a contained `Ok` value, leaving an `Err` value untouched.
 func Map[T any, U any](r Result[T], f func(T) U) Result[U] {
@@ -79,6 +112,16 @@ func MapOrElse[T any, U any](r Result[T], fallbackFn func(error) U, f func(T) U)
 	return f(r.data)
 }
 
+// Match applies `onOk` to the contained value (if `Ok`), or
+// `onErr` to the contained error (if `Err`). It is MapOrElse with
+// the functions in the order of the cases they handle.
+func Match[T any, U any](r Result[T], onOk func(T) U, onErr func(error) U) U {
+	if r.IsErr() {
+		return onErr(r.err)
+	}
+	return onOk(r.data)
+}
+
 // MapErr applies a function to the contained `Err` value,
 // leaving an `Ok` value untouched.
 func (r Result[T]) MapErr(f func(error) error) Result[T] {
@@ -123,6 +166,12 @@ func (r Result[T]) Unwrap() T {
 	return r.data
 }
 
+// Unpack returns the `Ok` value and a nil error, or the
+// default value of type T and the error if `Err`.
+func (r Result[T]) Unpack() (T, error) {
+	return r.data, r.err
+}
+
 // UnwrapOrDefault returns the `Ok` value, or the
 // default value of type T if `Err`.
 func (r Result[T]) UnwrapOrDefault() T {
@@ -233,7 +282,33 @@ func Flatten[T any](r Result[Result[T]]) Result[T] {
 	return r.data
 }
 
-// Equal tests deep equality of two results.
+// Transpose converts from a Result[[]T] to a []Result[T], with
+// an `Ok` value for each element of an `Ok` slice, or a single
+// `Err` value if the result is `Err`.
+func Transpose[T any](r Result[[]T]) []Result[T] {
+	if r.IsErr() {
+		return []Result[T]{Err[T](r.err)}
+	}
+	rs := make([]Result[T], len(r.data))
+	for i, t := range r.data {
+		rs[i] = Ok(t)
+	}
+	return rs
+}
+
+// Unzip converts from a Result[Pair[A, B]] to a Result[A] and a
+// Result[B], which are both `Err` with the same error if the
+// result is `Err`.
+func Unzip[A any, B any](r Result[Pair[A, B]]) (Result[A], Result[B]) {
+	if r.IsErr() {
+		return Err[A](r.err), Err[B](r.err)
+	}
+	return Ok(r.data.First), Ok(r.data.Second)
+}
+
+// Equal tests deep equality of two results. Errors are equal if they
+// have the same message, so unlike the Equal method, errors of
+// different types with the same message are equal.
 func Equal[T comparable](r, other Result[T]) bool {
 	if r.IsOk() && other.IsOk() {
 		return r.data == other.data
@@ -243,3 +318,46 @@ func Equal[T comparable](r, other Result[T]) bool {
 	}
 	return false
 }
+
+// String returns `Ok(value)` or `Err(message)`, implementing fmt.Stringer.
+func (r Result[T]) String() string {
+	if r.IsErr() {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.data)
+}
+
+// GoString returns a Go-syntax representation of the result,
+// implementing fmt.GoStringer.
+func (r Result[T]) GoString() string {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if r.IsErr() {
+		return fmt.Sprintf("result.Err[%s](%#v)", typ, r.err)
+	}
+	return fmt.Sprintf("result.Ok[%s](%#v)", typ, r.data)
+}
+
+// Equal reports whether the results are both `Ok` with deeply equal
+// values, or both `Err` with the same error. Errors are the same if
+// they have the same message and either match each other according to
+// errors.Is or have the same type, while the Equal function compares
+// only their messages. Unlike the Equal function, it works for any T,
+// which allows results to be compared by github.com/google/go-cmp.
+func (r Result[T]) Equal(other Result[T]) bool {
+	if r.IsOk() && other.IsOk() {
+		return reflect.DeepEqual(r.data, other.data)
+	}
+	if r.IsErr() && other.IsErr() {
+		return sameError(r.err, other.err)
+	}
+	return false
+}
+
+// sameError reports whether the errors have the same message and either
+// match each other according to errors.Is or have the same type.
+func sameError(err, other error) bool {
+	if err.Error() != other.Error() {
+		return false
+	}
+	return reflect.TypeOf(err) == reflect.TypeOf(other) || (errors.Is(err, other) && errors.Is(other, err))
+}
@@ -0,0 +1,85 @@
+package result
+
+// Pair holds two values.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Result2 is a result holding two values if it is `Ok`, for functions
+// returning (A, B, error).
+type Result2[A any, B any] struct {
+	first  A
+	second B
+	err    error
+}
+
+// Ok2 creates an `Ok` value holding both values.
+func Ok2[A any, B any](first A, second B) Result2[A, B] {
+	return Result2[A, B]{first: first, second: second}
+}
+
+// Err2 creates an `Err` value from an error. As with Err, a nil error
+// creates an `Ok` value holding zero values.
+func Err2[A any, B any](err error) Result2[A, B] {
+	return Result2[A, B]{err: err}
+}
+
+// From2 creates a result from the return values of a function returning
+// (A, B, error): `Err` if the error is not nil, and `Ok` otherwise.
+func From2[A any, B any](first A, second B, err error) Result2[A, B] {
+	if err != nil {
+		return Err2[A, B](err)
+	}
+	return Ok2(first, second)
+}
+
+// FromPair converts a Result[Pair[A, B]] to a Result2[A, B].
+func FromPair[A any, B any](r Result[Pair[A, B]]) Result2[A, B] {
+	if r.IsErr() {
+		return Err2[A, B](r.err)
+	}
+	return Ok2(r.data.First, r.data.Second)
+}
+
+// IsOk returns `true` if the result is `Ok`.
+func (r Result2[A, B]) IsOk() bool {
+	return !r.IsErr()
+}
+
+// IsErr returns `true` if the result is `Err`.
+func (r Result2[A, B]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unpack returns the contained values and a nil error if the result is
+// `Ok`, or zero values and the contained error if it is `Err`.
+func (r Result2[A, B]) Unpack() (A, B, error) {
+	return r.first, r.second, r.err
+}
+
+// Pair converts the result to a Result[Pair[A, B]].
+func (r Result2[A, B]) Pair() Result[Pair[A, B]] {
+	if r.IsErr() {
+		return Err[Pair[A, B]](r.err)
+	}
+	return Ok(Pair[A, B]{First: r.first, Second: r.second})
+}
+
+// Map2 maps a Result2[A, B] to Result[U] by applying a function to the
+// contained `Ok` values, leaving an `Err` value untouched.
+func Map2[A any, B any, U any](r Result2[A, B], f func(A, B) U) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.first, r.second))
+}
+
+// AndThen2 calls `f` with the contained values if the result is `Ok`,
+// otherwise returns the `Err` value.
+func AndThen2[A any, B any, U any](r Result2[A, B], f func(A, B) Result[U]) Result[U] {
+	if r.IsErr() {
+		return Err[U](r.err)
+	}
+	return f(r.first, r.second)
+}
@@ -0,0 +1,140 @@
+package result_test
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func TestFrom2(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		host  string
+		port  string
+		isErr bool
+	}{
+		"success": {
+			input: "localhost:80",
+			host:  "localhost",
+			port:  "80",
+		},
+		"error": {
+			input: "localhost",
+			isErr: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			r := result.From2(net.SplitHostPort(tc.input))
+			if r.IsErr() != tc.isErr || r.IsOk() == tc.isErr {
+				t.FailNow()
+			}
+			host, port, err := r.Unpack()
+			if host != tc.host || port != tc.port || (err != nil) != tc.isErr {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestOk2Err2(t *testing.T) {
+	if a, b, err := result.Ok2(1, "one").Unpack(); a != 1 || b != "one" || err != nil {
+		t.Fail()
+	}
+	failed := errors.New("failed")
+	if a, b, err := result.Err2[int, string](failed).Unpack(); a != 0 || b != "" || err != failed {
+		t.Fail()
+	}
+}
+
+func TestResult2Pair(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result2[int, string]
+		expected result.Result[result.Pair[int, string]]
+	}{
+		"success": {
+			value:    result.Ok2(1, "one"),
+			expected: result.Ok(result.Pair[int, string]{First: 1, Second: "one"}),
+		},
+		"error": {
+			value:    result.Err2[int, string](errors.New("failed")),
+			expected: result.Err[result.Pair[int, string]](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := tc.value.Pair()
+			if !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+			a, b, err := result.FromPair(got).Unpack()
+			ea, eb, eerr := tc.value.Unpack()
+			if a != ea || b != eb || (err == nil) != (eerr == nil) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestMap2(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result2[int, string]
+		expected result.Result[string]
+	}{
+		"success": {
+			value:    result.Ok2(1, "one"),
+			expected: result.Ok("1=one"),
+		},
+		"error": {
+			value:    result.Err2[int, string](errors.New("failed")),
+			expected: result.Err[string](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.Map2(tc.value, func(a int, b string) string {
+				return strconv.Itoa(a) + "=" + b
+			})
+			if !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAndThen2(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result2[string, string]
+		expected result.Result[int]
+	}{
+		"success": {
+			value:    result.From2(net.SplitHostPort("localhost:80")),
+			expected: result.Ok(80),
+		},
+		"function error": {
+			value:    result.From2(net.SplitHostPort("localhost:http")),
+			expected: result.Err[int](errors.New(`strconv.Atoi: parsing "http": invalid syntax`)),
+		},
+		"error": {
+			value:    result.Err2[string, string](errors.New("failed")),
+			expected: result.Err[int](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.AndThen2(tc.value, func(_ string, port string) result.Result[int] {
+				return result.From(strconv.Atoi(port))
+			})
+			if !result.Equal(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -8,6 +8,33 @@ import (
 	"github.com/JustinKnueppel/go-result"
 )
 
+func TestFrom(t *testing.T) {
+	tests := map[string]struct {
+		data     int
+		err      error
+		expected result.Result[int]
+	}{
+		"success": {
+			data:     1,
+			err:      nil,
+			expected: result.Ok(1),
+		},
+		"error": {
+			data:     1,
+			err:      errors.New("failed"),
+			expected: result.Err[int](errors.New("failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !result.Equal(result.From(tc.data, tc.err), tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
 func TestIsOk(t *testing.T) {
 	tests := map[string]struct {
 		value    result.Result[int]
@@ -257,6 +284,89 @@ func TestMapOrDifferentType(t *testing.T) {
 	}
 }
 
+func TestOkMethod(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		expected int
+		ok       bool
+	}{
+		"success": {
+			value:    result.Ok(1),
+			expected: 1,
+			ok:       true,
+		},
+		"error": {
+			value:    result.Err[int](errors.New("error")),
+			expected: 0,
+			ok:       false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if v, ok := tc.value.Ok(); v != tc.expected || ok != tc.ok {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestErrMethod(t *testing.T) {
+	failed := errors.New("error")
+	tests := map[string]struct {
+		value    result.Result[int]
+		expected error
+		ok       bool
+	}{
+		"success": {
+			value:    result.Ok(1),
+			expected: nil,
+			ok:       false,
+		},
+		"error": {
+			value:    result.Err[int](failed),
+			expected: failed,
+			ok:       true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if err, ok := tc.value.Err(); err != tc.expected || ok != tc.ok {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		expected string
+	}{
+		"success": {
+			value:    result.Ok(1),
+			expected: "ok 1",
+		},
+		"error": {
+			value:    result.Err[int](errors.New("error")),
+			expected: "err error",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.Match(tc.value,
+				func(i int) string { return fmt.Sprintf("ok %d", i) },
+				func(err error) string { return "err " + err.Error() },
+			)
+			if got != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
 func TestMapOrElseSameType(t *testing.T) {
 	closureConstant := 5
 	tests := map[string]struct {
@@ -497,6 +607,34 @@ func TestUnwrap(t *testing.T) {
 		})
 	}
 }
+func TestUnpack(t *testing.T) {
+	tests := map[string]struct {
+		value         result.Result[int]
+		inner         int
+		errorExpected bool
+	}{
+		"success": {
+			value:         result.Ok(1),
+			inner:         1,
+			errorExpected: false,
+		},
+		"error": {
+			value:         result.Err[int](errors.New("error")),
+			inner:         0,
+			errorExpected: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			val, err := tc.value.Unpack()
+			if val != tc.inner || (err != nil) != tc.errorExpected {
+				t.Fail()
+			}
+		})
+	}
+}
+
 func TestUnwrapOrDefault(t *testing.T) {
 	tests := map[string]struct {
 		value    result.Result[int]
@@ -946,6 +1084,68 @@ func TestFlatten(t *testing.T) {
 	}
 }
 
+func TestTranspose(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[[]int]
+		expected []result.Result[int]
+	}{
+		"success": {
+			value:    result.Ok([]int{1, 2}),
+			expected: []result.Result[int]{result.Ok(1), result.Ok(2)},
+		},
+		"empty": {
+			value:    result.Ok[[]int](nil),
+			expected: []result.Result[int]{},
+		},
+		"error": {
+			value:    result.Err[[]int](errors.New("error")),
+			expected: []result.Result[int]{result.Err[int](errors.New("error"))},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.Transpose(tc.value)
+			if len(got) != len(tc.expected) {
+				t.FailNow()
+			}
+			for i := range got {
+				if !result.Equal(got[i], tc.expected[i]) {
+					t.Fail()
+				}
+			}
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[result.Pair[int, string]]
+		first  result.Result[int]
+		second result.Result[string]
+	}{
+		"success": {
+			value:  result.Ok(result.Pair[int, string]{First: 1, Second: "one"}),
+			first:  result.Ok(1),
+			second: result.Ok("one"),
+		},
+		"error": {
+			value:  result.Err[result.Pair[int, string]](errors.New("error")),
+			first:  result.Err[int](errors.New("error")),
+			second: result.Err[string](errors.New("error")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			first, second := result.Unzip(tc.value)
+			if !result.Equal(first, tc.first) || !result.Equal(second, tc.second) {
+				t.Fail()
+			}
+		})
+	}
+}
+
 func TestEqual(t *testing.T) {
 	err := errors.New("failed")
 	tests := map[string]struct {
@@ -983,6 +1183,11 @@ func TestEqual(t *testing.T) {
 			other:    result.Err[string](fmt.Errorf("suberror: %w", err)),
 			expected: false,
 		},
+		"error_error_true_different_type": {
+			value:    result.Err[string](&pathError{path: "/"}),
+			other:    result.Err[string](errors.New("bad path /")),
+			expected: true,
+		},
 	}
 
 	for tname, tc := range tests {
@@ -993,3 +1198,112 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestString(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		expected string
+	}{
+		"success": {
+			value:    result.Ok(1),
+			expected: "Ok(1)",
+		},
+		"error": {
+			value:    result.Err[int](errors.New("failed")),
+			expected: "Err(failed)",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.String() != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestGoString(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[[]int]
+		expected string
+	}{
+		"success": {
+			value:    result.Ok([]int{1, 2}),
+			expected: "result.Ok[[]int]([]int{1, 2})",
+		},
+		"error": {
+			value:    result.Err[[]int](errors.New("failed")),
+			expected: `result.Err[[]int](&errors.errorString{s:"failed"})`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if fmt.Sprintf("%#v", tc.value) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+type pathError struct {
+	path string
+}
+
+func (e *pathError) Error() string {
+	return "bad path " + e.path
+}
+
+func TestEqualMethod(t *testing.T) {
+	err := errors.New("failed")
+	tests := map[string]struct {
+		value    result.Result[[]int]
+		other    result.Result[[]int]
+		expected bool
+	}{
+		"ok_ok_true": {
+			value:    result.Ok([]int{1}),
+			other:    result.Ok([]int{1}),
+			expected: true,
+		},
+		"ok_ok_false": {
+			value:    result.Ok([]int{1}),
+			other:    result.Ok([]int{2}),
+			expected: false,
+		},
+		"ok_error": {
+			value:    result.Ok([]int{1}),
+			other:    result.Err[[]int](err),
+			expected: false,
+		},
+		"error_error_identical": {
+			value:    result.Err[[]int](err),
+			other:    result.Err[[]int](err),
+			expected: true,
+		},
+		"error_error_same_message": {
+			value:    result.Err[[]int](err),
+			other:    result.Err[[]int](errors.New("failed")),
+			expected: true,
+		},
+		"error_error_different_type": {
+			value:    result.Err[[]int](&pathError{path: "/"}),
+			other:    result.Err[[]int](errors.New("bad path /")),
+			expected: false,
+		},
+		"error_error_suberror": {
+			value:    result.Err[[]int](err),
+			other:    result.Err[[]int](fmt.Errorf("suberror: %w", err)),
+			expected: false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if tc.value.Equal(tc.other) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+module github.com/JustinKnueppel/go-result/resultcmp
+
+go 1.22
+
+require (
+	github.com/JustinKnueppel/go-result v0.0.0-20261018203440-9e13c28716cc
+	github.com/google/go-cmp v0.7.0
+	github.com/stretchr/testify v1.12.1
+)
+
+require go.yaml.in/yaml/v3 v3.0.5 // indirect
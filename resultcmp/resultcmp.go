@@ -0,0 +1,103 @@
+// Package resultcmp integrates results with github.com/google/go-cmp
+// and github.com/stretchr/testify. It is a separate module so that the
+// result package itself stays free of dependencies.
+package resultcmp
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/JustinKnueppel/go-result"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+const resultPkgPath = "github.com/JustinKnueppel/go-result"
+
+// view is the comparable form of a result produced by Transformer.
+type view struct {
+	Ok    bool
+	Value any
+	Err   *errView
+}
+
+// errView holds an error along with its type and message, which are
+// shown in reports.
+type errView struct {
+	Type    string
+	Message string
+	err     error
+}
+
+// errViewsEqual compares errors as the Equal method of results does,
+// so that Transformer agrees with it.
+func errViewsEqual(a, b *errView) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return result.Err[struct{}](a.err).Equal(result.Err[struct{}](b.err))
+}
+
+// anyResult is implemented by every result, regardless of its type.
+type anyResult interface {
+	IsOk() bool
+	IsErrAnd(func(error) bool) bool
+}
+
+// Transformer returns a cmp.Option which compares results by variant,
+// value, and error. Values are compared by cmp, so other options apply
+// to them, and errors are compared as the Equal method of results
+// does: errors are equal if they have the same message and either
+// match each other according to errors.Is or have the same type.
+// Without this option,
+// cmp compares results with their Equal method.
+func Transformer() cmp.Option {
+	return cmp.Options{
+		cmp.FilterPath(func(p cmp.Path) bool {
+			return isResult(p.Last().Type())
+		}, cmp.Transformer("result.Result", toView)),
+		cmp.Comparer(errViewsEqual),
+	}
+}
+
+func isResult(t reflect.Type) bool {
+	return t != nil && t.PkgPath() == resultPkgPath && strings.HasPrefix(t.Name(), "Result[")
+}
+
+func toView(in any) view {
+	r, ok := in.(anyResult)
+	if !ok {
+		panic(fmt.Sprintf("resultcmp: %T is not a result", in))
+	}
+	if r.IsOk() {
+		value := reflect.ValueOf(in).MethodByName("Unwrap").Call(nil)[0].Interface()
+		return view{Ok: true, Value: value}
+	}
+	var v view
+	r.IsErrAnd(func(err error) bool {
+		v.Err = &errView{Type: fmt.Sprintf("%T", err), Message: err.Error(), err: err}
+		return true
+	})
+	return v
+}
+
+// Diff returns a human readable report of the differences between two
+// values as cmp.Diff does, comparing any results inside them with
+// Transformer.
+func Diff(want, got any, opts ...cmp.Option) string {
+	return cmp.Diff(want, got, append(opts, Transformer())...)
+}
+
+// AssertEqual asserts that two values are equal according to Diff,
+// failing with testify and printing the diff if they are not.
+func AssertEqual(t assert.TestingT, want, got any, msgAndArgs ...any) bool {
+	if h, ok := t.(interface{ Helper() }); ok {
+		h.Helper()
+	}
+	diff := Diff(want, got)
+	if diff == "" {
+		return true
+	}
+	return assert.Fail(t, "Not equal (-want +got):\n"+diff, msgAndArgs...)
+}
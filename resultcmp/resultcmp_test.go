@@ -0,0 +1,143 @@
+package resultcmp_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+	"github.com/JustinKnueppel/go-result/resultcmp"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+var errDefined = result.DefineErr("resultcmp_test/defined", 404, "defined")
+
+type response struct {
+	ID     int
+	Tags   []string
+	Lookup result.Result[[]string]
+}
+
+type fakeT struct {
+	msg string
+}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.msg = fmt.Sprintf(format, args...)
+}
+
+func TestDiff(t *testing.T) {
+	errNotFound := errors.New("not found")
+	tests := map[string]struct {
+		want     response
+		got      response
+		opts     []cmp.Option
+		expected bool
+	}{
+		"ok_equal": {
+			want:     response{ID: 1, Lookup: result.Ok([]string{"a"})},
+			got:      response{ID: 1, Lookup: result.Ok([]string{"a"})},
+			expected: true,
+		},
+		"ok_different": {
+			want:     response{ID: 1, Lookup: result.Ok([]string{"a"})},
+			got:      response{ID: 1, Lookup: result.Ok([]string{"b"})},
+			expected: false,
+		},
+		"ok_options_apply_to_value": {
+			want:     response{ID: 1, Lookup: result.Ok([]string{"a", "b"})},
+			got:      response{ID: 1, Lookup: result.Ok([]string{"b", "a"})},
+			opts:     []cmp.Option{cmpopts.SortSlices(func(a, b string) bool { return a < b })},
+			expected: true,
+		},
+		"err_equal": {
+			want:     response{Lookup: result.Err[[]string](errNotFound)},
+			got:      response{Lookup: result.Err[[]string](errors.New("not found"))},
+			expected: true,
+		},
+		"err_different": {
+			want:     response{Lookup: result.Err[[]string](errNotFound)},
+			got:      response{Lookup: result.Err[[]string](errors.New("timeout"))},
+			expected: false,
+		},
+		"err_same_details": {
+			want:     response{Lookup: result.Err[[]string](errDefined.New("a"))},
+			got:      response{Lookup: result.Err[[]string](errDefined.New("a"))},
+			expected: true,
+		},
+		"err_different_details": {
+			want:     response{Lookup: result.Err[[]string](errDefined.New("a"))},
+			got:      response{Lookup: result.Err[[]string](errDefined.New("b"))},
+			expected: false,
+		},
+		"err_same_message_different_type": {
+			want:     response{Lookup: result.Err[[]string](errNotFound)},
+			got:      response{Lookup: result.Err[[]string](fmt.Errorf("%w", errors.New("not found")))},
+			expected: false,
+		},
+		"ok_err": {
+			want:     response{Lookup: result.Ok[[]string](nil)},
+			got:      response{Lookup: result.Err[[]string](errNotFound)},
+			expected: false,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			diff := resultcmp.Diff(tc.want, tc.got, tc.opts...)
+			if (diff == "") != tc.expected {
+				t.Errorf("unexpected diff:\n%s", diff)
+			}
+			if len(tc.opts) == 0 && tc.want.Lookup.Equal(tc.got.Lookup) != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestCmpUsesEqualMethod(t *testing.T) {
+	want := response{ID: 1, Lookup: result.Err[[]string](errors.New("not found"))}
+	got := response{ID: 1, Lookup: result.Err[[]string](errors.New("not found"))}
+	if !cmp.Equal(want, got) {
+		t.Fail()
+	}
+	got.Lookup = result.Ok([]string{"a"})
+	if cmp.Equal(want, got) {
+		t.Fail()
+	}
+}
+
+func TestAssertEqual(t *testing.T) {
+	tests := map[string]struct {
+		want     result.Result[int]
+		got      result.Result[int]
+		expected bool
+		msg      string
+	}{
+		"equal": {
+			want:     result.Ok(1),
+			got:      result.Ok(1),
+			expected: true,
+		},
+		"not_equal": {
+			want:     result.Ok(1),
+			got:      result.Ok(2),
+			expected: false,
+			msg:      "Not equal (-want +got)",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ft := &fakeT{}
+			if resultcmp.AssertEqual(ft, tc.want, tc.got) != tc.expected {
+				t.Fail()
+			}
+			if !strings.Contains(ft.msg, tc.msg) {
+				t.Fail()
+			}
+		})
+	}
+}
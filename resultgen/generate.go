@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const resultPkgPath = "github.com/JustinKnueppel/go-result"
+
+// config describes what to generate.
+type config struct {
+	// pkg is the pattern of the package containing the APIs to wrap.
+	pkg string
+	// typeName is the interface or type to wrap. If empty, the exported
+	// functions of the package are wrapped instead.
+	typeName string
+	// reverse generates (T, error) adapters for APIs returning results,
+	// instead of wrapping (T, error) APIs to return results.
+	reverse bool
+	// name is the name of the generated type.
+	name string
+	// outPkgName and outPkgPath are the name and import path of the
+	// package the generated code belongs to.
+	outPkgName string
+	outPkgPath string
+}
+
+// generate returns the formatted source of the wrappers described by
+// the config.
+func generate(cfg config) ([]byte, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes}, cfg.pkg)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("pattern %q matched %d packages, expected 1", cfg.pkg, len(pkgs))
+	}
+	if len(pkgs[0].Errors) > 0 {
+		return nil, pkgs[0].Errors[0]
+	}
+	pkg := pkgs[0].Types
+
+	g := &generator{
+		reverse: cfg.reverse,
+		imports: newImports(cfg.outPkgPath),
+	}
+	if cfg.typeName == "" {
+		if pkg.Path() == cfg.outPkgPath {
+			return nil, errors.New("package functions must be wrapped in a different package")
+		}
+		g.packageFuncs(pkg)
+	} else if err := g.typeMethods(pkg, cfg.typeName, cfg.name); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by resultgen; DO NOT EDIT.\n\npackage %s\n\n", cfg.outPkgName)
+	out.WriteString(g.imports.decl())
+	out.Write(g.body.Bytes())
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w\n%s", err, out.Bytes())
+	}
+	return src, nil
+}
+
+type generator struct {
+	reverse bool
+	imports *imports
+	body    bytes.Buffer
+}
+
+// packageFuncs writes a wrapper for each exported, non-generic function
+// of the package.
+func (g *generator) packageFuncs(pkg *types.Package) {
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		fn, ok := scope.Lookup(name).(*types.Func)
+		if !ok || !fn.Exported() {
+			continue
+		}
+		sig := fn.Type().(*types.Signature)
+		if sig.TypeParams() != nil {
+			continue
+		}
+		callee := g.imports.qualifier(pkg) + "." + name
+		fmt.Fprintf(&g.body, "\n// %s calls %s", name, callee)
+		g.writeFunc(name, "func "+name, callee, sig, "")
+	}
+}
+
+// typeMethods writes a type wrapping the named interface or type, with
+// a wrapper for each of its exported methods.
+func (g *generator) typeMethods(pkg *types.Package, typeName, name string) error {
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return fmt.Errorf("type %s not found in %s", typeName, pkg.Path())
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return fmt.Errorf("%s is not a named type", typeName)
+	}
+	if named.TypeParams() != nil {
+		return fmt.Errorf("generic type %s is not supported", typeName)
+	}
+
+	var inner types.Type = named
+	if !types.IsInterface(named) {
+		inner = types.NewPointer(named)
+	}
+	innerStr := types.TypeString(inner, g.imports.qualifier)
+	if g.reverse {
+		fmt.Fprintf(&g.body, "\n// %s adapts %s to return (T, error) instead of results.\n", name, innerStr)
+	} else {
+		fmt.Fprintf(&g.body, "\n// %s wraps %s to return results instead of (T, error).\n", name, innerStr)
+	}
+	fmt.Fprintf(&g.body, "type %s struct {\n\tInner %s\n}\n", name, innerStr)
+
+	mset := types.NewMethodSet(inner)
+	for i := 0; i < mset.Len(); i++ {
+		fn := mset.At(i).Obj().(*types.Func)
+		if !fn.Exported() {
+			continue
+		}
+		sig := fn.Type().(*types.Signature)
+		recv := "w"
+		fmt.Fprintf(&g.body, "\n// %s calls %s on the wrapped value", fn.Name(), fn.Name())
+		g.writeFunc(fn.Name(), fmt.Sprintf("func (%s %s) %s", recv, name, fn.Name()), recv+".Inner."+fn.Name(), sig, recv)
+	}
+	return nil
+}
+
+// writeFunc finishes the doc comment started by the caller and writes
+// a function with the given header calling `callee`, converting between
+// (T, error) and results according to the direction of the generator.
+func (g *generator) writeFunc(name, header, callee string, sig *types.Signature, recv string) {
+	params, args := g.params(sig, recv)
+	call := fmt.Sprintf("%s(%s)", callee, args)
+	results := sig.Results()
+
+	switch {
+	case !g.reverse && returnsPartial(name, results):
+		fmt.Fprintf(&g.body, " and returns its values unchanged, as it may return a value along with an error.\n%s(%s)%s {\n\treturn %s\n}\n", header, params, g.results(results), call)
+	case !g.reverse && results.Len() == 1 && isError(results.At(0).Type()):
+		g.imports.add(resultPkgPath, "result")
+		fmt.Fprintf(&g.body, " and returns its error as a result.\n%s(%s) result.Result[struct{}] {\n\treturn result.From(struct{}{}, %s)\n}\n", header, params, call)
+	case !g.reverse && results.Len() == 2 && isError(results.At(1).Type()):
+		g.imports.add(resultPkgPath, "result")
+		fmt.Fprintf(&g.body, " and returns its values as a result.\n%s(%s) result.Result[%s] {\n\treturn result.From(%s)\n}\n", header, params, g.typeString(results.At(0).Type()), call)
+	case g.reverse && results.Len() == 1 && isResultOf(results.At(0).Type(), isEmptyStruct):
+		fmt.Fprintf(&g.body, " and returns the error of its result.\n%s(%s) error {\n\t_, err := %s.Unpack()\n\treturn err\n}\n", header, params, call)
+	case g.reverse && results.Len() == 1 && isResultOf(results.At(0).Type(), nil):
+		valueType := types.Unalias(results.At(0).Type()).(*types.Named).TypeArgs().At(0)
+		fmt.Fprintf(&g.body, " and returns its result as a value and error.\n%s(%s) (%s, error) {\n\treturn %s.Unpack()\n}\n", header, params, g.typeString(valueType), call)
+	default:
+		fmt.Fprintf(&g.body, ".\n%s(%s)%s {\n\t", header, params, g.results(results))
+		if results.Len() > 0 {
+			g.body.WriteString("return ")
+		}
+		fmt.Fprintf(&g.body, "%s\n}\n", call)
+	}
+}
+
+// params returns the parameter list of the signature and the arguments
+// to forward them. Unnamed parameters, and parameters which would shadow
+// the receiver, an import, or a variable of the generated body, are
+// given new names.
+func (g *generator) params(sig *types.Signature, recv string) (string, string) {
+	// Qualify every parameter type first so that every import whose
+	// name could be shadowed is known.
+	typeStrs := make([]string, sig.Params().Len())
+	for i := range typeStrs {
+		t := sig.Params().At(i).Type()
+		if sig.Variadic() && i == len(typeStrs)-1 {
+			typeStrs[i] = "..." + g.typeString(t.(*types.Slice).Elem())
+		} else {
+			typeStrs[i] = g.typeString(t)
+		}
+	}
+	used := map[string]bool{}
+	var params, args []string
+	for i, typeStr := range typeStrs {
+		name := sig.Params().At(i).Name()
+		if name == "" || name == "_" || name == "err" || name == recv || g.imports.names[name] || used[name] {
+			name = fmt.Sprintf("p%d", i)
+		}
+		used[name] = true
+		params = append(params, name+" "+typeStr)
+		if strings.HasPrefix(typeStr, "...") {
+			name += "..."
+		}
+		args = append(args, name)
+	}
+	return strings.Join(params, ", "), strings.Join(args, ", ")
+}
+
+func (g *generator) results(results *types.Tuple) string {
+	switch results.Len() {
+	case 0:
+		return ""
+	case 1:
+		return " " + g.typeString(results.At(0).Type())
+	}
+	var list []string
+	for i := 0; i < results.Len(); i++ {
+		list = append(list, g.typeString(results.At(i).Type()))
+	}
+	return " (" + strings.Join(list, ", ") + ")"
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, g.imports.qualifier)
+}
+
+// partialNames holds the names of common functions and methods which
+// report progress made before an error, such as io.Reader.Read.
+var partialNames = map[string]bool{
+	"Copy":        true,
+	"CopyBuffer":  true,
+	"CopyN":       true,
+	"Read":        true,
+	"ReadAt":      true,
+	"ReadAtLeast": true,
+	"ReadFrom":    true,
+	"ReadFull":    true,
+	"Write":       true,
+	"WriteAt":     true,
+	"WriteString": true,
+	"WriteTo":     true,
+}
+
+// returnsPartial reports whether the function returns a (T, error)
+// whose value is meaningful even when the error is not nil, so that
+// converting it to a result would lose data. This is the case for the
+// functions in partialNames, and for values named n, which by
+// convention count the progress made before an error.
+func returnsPartial(name string, results *types.Tuple) bool {
+	if results.Len() != 2 || !isError(results.At(1).Type()) {
+		return false
+	}
+	return partialNames[name] || results.At(0).Name() == "n"
+}
+
+var errorType = types.Universe.Lookup("error").Type()
+
+func isError(t types.Type) bool {
+	return types.Identical(t, errorType)
+}
+
+func isEmptyStruct(t types.Type) bool {
+	s, ok := t.(*types.Struct)
+	return ok && s.NumFields() == 0
+}
+
+// isResultOf reports whether the type is a Result whose type argument
+// satisfies the predicate, if one is given.
+func isResultOf(t types.Type, predicate func(types.Type) bool) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != resultPkgPath || obj.Name() != "Result" {
+		return false
+	}
+	return predicate == nil || predicate(named.TypeArgs().At(0))
+}
+
+// imports tracks the packages referenced by the generated code and the
+// names they are imported as.
+type imports struct {
+	self   string
+	byPath map[string]string
+	names  map[string]bool
+}
+
+// newImports returns an empty set of imports for code in the package
+// with the given path. The name "result" is reserved for the result
+// package, even before it is imported.
+func newImports(self string) *imports {
+	return &imports{
+		self:   self,
+		byPath: map[string]string{},
+		names:  map[string]bool{"result": true},
+	}
+}
+
+func (im *imports) qualifier(pkg *types.Package) string {
+	if pkg.Path() == im.self {
+		return ""
+	}
+	return im.add(pkg.Path(), pkg.Name())
+}
+
+// add imports the package, returning the name it is imported as. Names
+// already in use by another package are given a numeric suffix.
+func (im *imports) add(path, name string) string {
+	if existing, ok := im.byPath[path]; ok {
+		return existing
+	}
+	if path == resultPkgPath {
+		im.byPath[path] = "result"
+		return "result"
+	}
+	unique := name
+	for i := 2; im.names[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	im.byPath[path] = unique
+	im.names[unique] = true
+	return unique
+}
+
+// decl returns the import declaration, with standard library packages
+// grouped before other packages.
+func (im *imports) decl() string {
+	if len(im.byPath) == 0 {
+		return ""
+	}
+	var std, other []string
+	for path, name := range im.byPath {
+		spec := fmt.Sprintf("%q", path)
+		if name != path[strings.LastIndex(path, "/")+1:] {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Slice(std, func(i, j int) bool { return unquoted(std[i]) < unquoted(std[j]) })
+	sort.Slice(other, func(i, j int) bool { return unquoted(other[i]) < unquoted(other[j]) })
+
+	var sb strings.Builder
+	sb.WriteString("import (\n")
+	for _, spec := range std {
+		sb.WriteString("\t" + spec + "\n")
+	}
+	if len(std) > 0 && len(other) > 0 {
+		sb.WriteString("\n")
+	}
+	for _, spec := range other {
+		sb.WriteString("\t" + spec + "\n")
+	}
+	sb.WriteString(")\n")
+	return sb.String()
+}
+
+func unquoted(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/tools/go/packages"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+const examplePkg = "example.com/example"
+
+// TestMain loads packages from testdata/src in GOPATH mode, so that
+// the example package imports the stub of the result package there.
+func TestMain(m *testing.M) {
+	gopath, err := filepath.Abs("testdata")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Setenv("GOPATH", gopath)
+	os.Setenv("GO111MODULE", "off")
+	os.Exit(m.Run())
+}
+
+func TestGenerate(t *testing.T) {
+	tests := map[string]struct {
+		cfg config
+	}{
+		"interface": {
+			cfg: config{pkg: examplePkg, typeName: "Store", name: "StoreResult", outPkgName: "gen", outPkgPath: "example.com/gen"},
+		},
+		"interface_same_package": {
+			cfg: config{pkg: examplePkg, typeName: "Store", name: "StoreResult", outPkgName: "example", outPkgPath: examplePkg},
+		},
+		"concrete": {
+			cfg: config{pkg: examplePkg, typeName: "Counter", name: "CounterResult", outPkgName: "gen", outPkgPath: "example.com/gen"},
+		},
+		"reverse": {
+			cfg: config{pkg: examplePkg, typeName: "ResultStore", name: "ResultStoreAdapter", reverse: true, outPkgName: "gen", outPkgPath: "example.com/gen"},
+		},
+		"package": {
+			cfg: config{pkg: examplePkg, outPkgName: "gen", outPkgPath: "example.com/gen"},
+		},
+		"stdlib": {
+			cfg: config{pkg: "io", typeName: "ReadWriteCloser", name: "ReadWriteCloserResult", outPkgName: "gen", outPkgPath: "example.com/gen"},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			src, err := generate(tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden := filepath.Join("testdata", tname+".golden")
+			if *update {
+				if err := os.WriteFile(golden, src, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(src) != string(want) {
+				t.Errorf("generated code does not match %s:\n%s", golden, src)
+			}
+			typeCheck(t, tc.cfg, src)
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := map[string]struct {
+		cfg config
+	}{
+		"missing_type": {
+			cfg: config{pkg: examplePkg, typeName: "Missing", outPkgName: "gen"},
+		},
+		"not_named": {
+			cfg: config{pkg: examplePkg, typeName: "Load", outPkgName: "gen"},
+		},
+		"package_into_itself": {
+			cfg: config{pkg: examplePkg, outPkgName: "example", outPkgPath: examplePkg},
+		},
+		"missing_package": {
+			cfg: config{pkg: "example.com/missing", typeName: "Store", outPkgName: "gen"},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if _, err := generate(tc.cfg); err == nil {
+				t.Fail()
+			}
+		})
+	}
+}
+
+// typeCheck checks that the generated code compiles as part of the
+// package it was generated for.
+func typeCheck(t *testing.T, cfg config, src []byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "generated.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []*ast.File{file}
+	if cfg.outPkgPath == examplePkg {
+		pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedSyntax | packages.NeedFiles, Fset: fset}, examplePkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, pkgs[0].Syntax...)
+	}
+	deps, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes}, "io", resultPkgPath, examplePkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byPath := map[string]*types.Package{}
+	for _, dep := range deps {
+		byPath[dep.PkgPath] = dep.Types
+	}
+	conf := types.Config{Importer: importerFunc(func(path string) (*types.Package, error) {
+		if pkg, ok := byPath[path]; ok {
+			return pkg, nil
+		}
+		return nil, fmt.Errorf("unexpected import %q", path)
+	})}
+	if _, err := conf.Check(cfg.outPkgPath, fset, files, nil); err != nil {
+		t.Errorf("generated code does not compile: %v", err)
+	}
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
@@ -0,0 +1,107 @@
+// Command resultgen generates wrappers which adapt APIs returning
+// (T, error) to return results, or the reverse. It is intended to be
+// run by go generate:
+//
+//	//go:generate resultgen -pkg database/sql -type DB
+//
+// This writes db_result.go, declaring a DBResult type which wraps a
+// *sql.DB and whose Query method returns a result.Result[*sql.Rows].
+// Methods returning only an error return a result.Result[struct{}], and
+// other methods are forwarded unchanged.
+//
+// A result cannot hold a value and an error at once, so methods which
+// may return both, such as io.Reader.Read returning the bytes read
+// along with io.EOF, are also forwarded unchanged. These are recognized
+// by the names of common I/O methods such as Read and Write, and by
+// value results named n.
+//
+// Without -type, every exported function of the package is wrapped by a
+// function of the same name. With -reverse, methods and functions
+// returning results are adapted to return a value and an error instead.
+//
+// Usage:
+//
+//	resultgen [flags]
+//
+// The flags are:
+//
+//	-pkg pattern
+//		package containing the APIs to wrap (default ".")
+//	-type name
+//		interface or type to wrap; if empty, package functions are wrapped
+//	-reverse
+//		adapt APIs returning results to return (T, error)
+//	-name name
+//		name of the generated type (default <type>Result, or
+//		<type>Adapter with -reverse)
+//	-output file
+//		output file, or "-" for standard output (default
+//		<type>_result.go, or <package>_result.go without -type)
+//	-package name
+//		package name of the generated code (default $GOPACKAGE, or the
+//		package in the current directory)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+func main() {
+	var cfg config
+	var output string
+	flag.StringVar(&cfg.pkg, "pkg", ".", "package containing the APIs to wrap")
+	flag.StringVar(&cfg.typeName, "type", "", "interface or type to wrap; if empty, package functions are wrapped")
+	flag.BoolVar(&cfg.reverse, "reverse", false, "adapt APIs returning results to return (T, error)")
+	flag.StringVar(&cfg.name, "name", "", "name of the generated type")
+	flag.StringVar(&output, "output", "", "output file, or \"-\" for standard output")
+	flag.StringVar(&cfg.outPkgName, "package", os.Getenv("GOPACKAGE"), "package name of the generated code")
+	flag.Parse()
+
+	if err := run(cfg, output); err != nil {
+		fmt.Fprintln(os.Stderr, "resultgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(cfg config, output string) error {
+	// The package in the current directory determines how the generated
+	// code refers to its own package. It may not exist yet.
+	if pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName}, "."); err == nil && len(pkgs) == 1 {
+		cfg.outPkgPath = pkgs[0].PkgPath
+		if cfg.outPkgName == "" {
+			cfg.outPkgName = pkgs[0].Name
+		}
+	}
+	if cfg.outPkgName == "" {
+		return fmt.Errorf("cannot determine package name; use -package")
+	}
+	if cfg.name == "" && cfg.typeName != "" {
+		if cfg.reverse {
+			cfg.name = cfg.typeName + "Adapter"
+		} else {
+			cfg.name = cfg.typeName + "Result"
+		}
+	}
+	if output == "" {
+		base := cfg.typeName
+		if base == "" {
+			base = cfg.pkg[strings.LastIndex(cfg.pkg, "/")+1:]
+		}
+		output = strings.ToLower(base) + "_result.go"
+	}
+
+	src, err := generate(cfg)
+	if err != nil {
+		return err
+	}
+	if output == "-" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(output, src, 0o644)
+}
@@ -0,0 +1,63 @@
+// Package example declares APIs for resultgen tests.
+package example
+
+import (
+	"io"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+type User struct {
+	Name string
+}
+
+// Store returns values paired with errors.
+type Store interface {
+	io.Closer
+	Get(id string) (User, error)
+	Find(result string, limit int, tags ...string) ([]User, error)
+	Pair(int) (int, int, error)
+	Len() int
+	Reset()
+}
+
+// UserResult is an alias of the result of a user lookup.
+type UserResult = result.Result[User]
+
+// ResultStore returns results.
+type ResultStore interface {
+	Get(id string) result.Result[User]
+	First() UserResult
+	Delete(id string) result.Result[struct{}]
+	Len() int
+}
+
+// Counter is a concrete type with pointer methods.
+type Counter struct {
+	n int
+}
+
+func (c *Counter) Inc() (int, error) {
+	c.n++
+	return c.n, nil
+}
+
+func (c *Counter) reset() {
+	c.n = 0
+}
+
+func Load(path string) (User, error) {
+	return User{Name: path}, nil
+}
+
+func Save(u User) error {
+	return nil
+}
+
+func Identity[T any](t T) (T, error) {
+	return t, nil
+}
+
+func Fetch(id string) result.Result[User] {
+	return result.Ok(User{Name: id})
+}
@@ -0,0 +1,12 @@
+// Package result is a stub of github.com/JustinKnueppel/go-result for
+// generator tests.
+package result
+
+type Result[T any] struct {
+	data T
+	err  error
+}
+
+func Ok[T any](data T) Result[T]              { return Result[T]{data: data} }
+func From[T any](data T, err error) Result[T] { return Result[T]{data: data, err: err} }
+func (r Result[T]) Unpack() (T, error)        { return r.data, r.err }
@@ -0,0 +1,72 @@
+// Package resultstd provides versions of common standard library
+// functions which return results. Each function returns the same value
+// and error as the function it wraps. Functions taking a single input
+// can be passed to result.AndThen directly, and functions which need
+// more arguments take them first and return such a function.
+package resultstd
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+// ReadFile calls os.ReadFile.
+func ReadFile(name string) result.Result[[]byte] {
+	return result.From(os.ReadFile(name))
+}
+
+// Open calls os.Open.
+func Open(name string) result.Result[*os.File] {
+	return result.From(os.Open(name))
+}
+
+// Atoi calls strconv.Atoi.
+func Atoi(s string) result.Result[int] {
+	return result.From(strconv.Atoi(s))
+}
+
+// ParseFloat returns a function which calls strconv.ParseFloat with
+// the given bit size.
+func ParseFloat(bitSize int) func(string) result.Result[float64] {
+	return func(s string) result.Result[float64] {
+		return result.From(strconv.ParseFloat(s, bitSize))
+	}
+}
+
+// ParseBool calls strconv.ParseBool.
+func ParseBool(s string) result.Result[bool] {
+	return result.From(strconv.ParseBool(s))
+}
+
+// UnmarshalJSON calls json.Unmarshal with a new value of type T.
+func UnmarshalJSON[T any](data []byte) result.Result[T] {
+	var t T
+	if err := json.Unmarshal(data, &t); err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(t)
+}
+
+// ParseURL calls url.Parse.
+func ParseURL(rawURL string) result.Result[*url.URL] {
+	return result.From(url.Parse(rawURL))
+}
+
+// ParseTime returns a function which calls time.Parse with the given
+// layout.
+func ParseTime(layout string) func(string) result.Result[time.Time] {
+	return func(value string) result.Result[time.Time] {
+		return result.From(time.Parse(layout, value))
+	}
+}
+
+// CompileRegexp calls regexp.Compile.
+func CompileRegexp(expr string) result.Result[*regexp.Regexp] {
+	return result.From(regexp.Compile(expr))
+}
@@ -0,0 +1,229 @@
+package resultstd_test
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/JustinKnueppel/go-result"
+	"github.com/JustinKnueppel/go-result/resultstd"
+)
+
+// matches reports whether the result holds the same value and error
+// as the standard library call.
+func matches[T any](r result.Result[T], value T, err error) bool {
+	got, gotErr := r.Unpack()
+	if err != nil {
+		return reflect.DeepEqual(gotErr, err)
+	}
+	return gotErr == nil && reflect.DeepEqual(got, value)
+}
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing")
+	if err := os.WriteFile(existing, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		name string
+	}{
+		"success": {name: existing},
+		"error":   {name: filepath.Join(dir, "missing")},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			data, err := os.ReadFile(tc.name)
+			if !matches(resultstd.ReadFile(tc.name), data, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestOpen(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "existing")
+	if err := os.WriteFile(existing, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]struct {
+		name          string
+		errorExpected bool
+	}{
+		"success": {name: existing, errorExpected: false},
+		"error":   {name: filepath.Join(dir, "missing"), errorExpected: true},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			r := resultstd.Open(tc.name)
+			if r.IsErr() != tc.errorExpected {
+				t.FailNow()
+			}
+			r.Inspect(func(f *os.File) {
+				defer f.Close()
+				if f.Name() != tc.name {
+					t.Fail()
+				}
+			})
+			_, err := os.Open(tc.name)
+			if tc.errorExpected && !reflect.DeepEqual(r.UnwrapErr(), err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	tests := map[string]struct {
+		s string
+	}{
+		"success": {s: "42"},
+		"error":   {s: "forty-two"},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			i, err := strconv.Atoi(tc.s)
+			if !matches(resultstd.Atoi(tc.s), i, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	tests := map[string]struct {
+		s       string
+		bitSize int
+	}{
+		"success":    {s: "1.5", bitSize: 64},
+		"success_32": {s: "0.1", bitSize: 32},
+		"error":      {s: "one", bitSize: 64},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			f, err := strconv.ParseFloat(tc.s, tc.bitSize)
+			if !matches(resultstd.ParseFloat(tc.bitSize)(tc.s), f, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseBool(t *testing.T) {
+	tests := map[string]struct {
+		s string
+	}{
+		"success": {s: "true"},
+		"error":   {s: "yes"},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			b, err := strconv.ParseBool(tc.s)
+			if !matches(resultstd.ParseBool(tc.s), b, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	type config struct {
+		Name string `json:"name"`
+	}
+	tests := map[string]struct {
+		data string
+	}{
+		"success":    {data: `{"name": "app"}`},
+		"error":      {data: `{"name": `},
+		"type_error": {data: `{"name": 1}`},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var c config
+			err := json.Unmarshal([]byte(tc.data), &c)
+			if !matches(resultstd.UnmarshalJSON[config]([]byte(tc.data)), c, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	tests := map[string]struct {
+		raw string
+	}{
+		"success": {raw: "https://example.com/path?q=1"},
+		"error":   {raw: "://missing-scheme"},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			u, err := url.Parse(tc.raw)
+			if !matches(resultstd.ParseURL(tc.raw), u, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := map[string]struct {
+		layout string
+		value  string
+	}{
+		"success": {layout: time.DateOnly, value: "2024-02-29"},
+		"error":   {layout: time.DateOnly, value: "2023-02-29"},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ts, err := time.Parse(tc.layout, tc.value)
+			if !matches(resultstd.ParseTime(tc.layout)(tc.value), ts, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestCompileRegexp(t *testing.T) {
+	tests := map[string]struct {
+		expr string
+	}{
+		"success": {expr: `^a+b$`},
+		"error":   {expr: `a(b`},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			re, err := regexp.Compile(tc.expr)
+			if !matches(resultstd.CompileRegexp(tc.expr), re, err) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAndThenChain(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "port")
+	if err := os.WriteFile(name, []byte("8080"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	port := result.AndThen(result.Map(resultstd.ReadFile(name), func(b []byte) string { return string(b) }), resultstd.Atoi)
+	if !result.Equal(port, result.Ok(8080)) {
+		t.Fail()
+	}
+}
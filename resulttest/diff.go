@@ -0,0 +1,173 @@
+package resulttest
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Diff returns a line diff of the readable forms of two values. Lines
+// only in `want` are prefixed with "-", and lines only in `got` with "+".
+func Diff(want, got any) string {
+	a := strings.Split(Format(want), "\n")
+	b := strings.Split(Format(got), "\n")
+
+	// lcs[i][j] is the length of the longest common subsequence of
+	// a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var sb strings.Builder
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			sb.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString("- " + a[i] + "\n")
+			i++
+		default:
+			sb.WriteString("+ " + b[j] + "\n")
+			j++
+		}
+	}
+	return sb.String()
+}
+
+// Format returns a readable, multi-line form of a value. Results and
+// other fmt.Stringers are printed with their String method, errors
+// with their message, and unexported fields are included.
+func Format(v any) string {
+	var sb strings.Builder
+	format(&sb, reflect.ValueOf(v), "", map[uintptr]bool{})
+	return sb.String()
+}
+
+var (
+	errorType    = reflect.TypeOf((*error)(nil)).Elem()
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+func format(sb *strings.Builder, v reflect.Value, indent string, seen map[uintptr]bool) {
+	if !v.IsValid() {
+		sb.WriteString("nil")
+		return
+	}
+	if v.CanInterface() && (v.Kind() != reflect.Pointer && v.Kind() != reflect.Interface || !v.IsNil()) {
+		switch {
+		case v.Type().Implements(errorType):
+			sb.WriteString("error(" + strconv.Quote(v.Interface().(error).Error()) + ")")
+			return
+		case v.Type().Implements(stringerType):
+			sb.WriteString(v.Interface().(fmt.Stringer).String())
+			return
+		}
+	}
+
+	inner := indent + "\t"
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			sb.WriteString("nil")
+			return
+		}
+		if seen[v.Pointer()] {
+			sb.WriteString("<cycle>")
+			return
+		}
+		seen[v.Pointer()] = true
+		defer delete(seen, v.Pointer())
+		sb.WriteString("&")
+		format(sb, v.Elem(), indent, seen)
+	case reflect.Interface:
+		if v.IsNil() {
+			sb.WriteString("nil")
+			return
+		}
+		format(sb, v.Elem(), indent, seen)
+	case reflect.Struct:
+		sb.WriteString(v.Type().String() + "{")
+		if v.NumField() == 0 {
+			sb.WriteString("}")
+			return
+		}
+		sb.WriteString("\n")
+		for i := 0; i < v.NumField(); i++ {
+			sb.WriteString(inner + v.Type().Field(i).Name + ": ")
+			format(sb, v.Field(i), inner, seen)
+			sb.WriteString(",\n")
+		}
+		sb.WriteString(indent + "}")
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			sb.WriteString(v.Type().String() + "(nil)")
+			return
+		}
+		sb.WriteString(v.Type().String() + "{")
+		if v.Len() == 0 {
+			sb.WriteString("}")
+			return
+		}
+		sb.WriteString("\n")
+		for i := 0; i < v.Len(); i++ {
+			sb.WriteString(inner)
+			format(sb, v.Index(i), inner, seen)
+			sb.WriteString(",\n")
+		}
+		sb.WriteString(indent + "}")
+	case reflect.Map:
+		if v.IsNil() {
+			sb.WriteString(v.Type().String() + "(nil)")
+			return
+		}
+		sb.WriteString(v.Type().String() + "{")
+		if v.Len() == 0 {
+			sb.WriteString("}")
+			return
+		}
+		sb.WriteString("\n")
+		entries := make([]string, 0, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			var entry strings.Builder
+			format(&entry, iter.Key(), inner, seen)
+			entry.WriteString(": ")
+			format(&entry, iter.Value(), inner, seen)
+			entries = append(entries, entry.String())
+		}
+		sort.Strings(entries)
+		for _, entry := range entries {
+			sb.WriteString(inner + entry + ",\n")
+		}
+		sb.WriteString(indent + "}")
+	case reflect.String:
+		sb.WriteString(strconv.Quote(v.String()))
+	case reflect.Bool:
+		sb.WriteString(strconv.FormatBool(v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sb.WriteString(strconv.FormatInt(v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		sb.WriteString(strconv.FormatUint(v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		sb.WriteString(strconv.FormatFloat(v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		sb.WriteString(strconv.FormatComplex(v.Complex(), 'g', -1, 128))
+	default:
+		sb.WriteString(v.Type().String())
+	}
+}
@@ -0,0 +1,42 @@
+package resulttest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+// Generate returns a random result which is `Ok` or `Err` with equal
+// probability. `Ok` values are generated by testing/quick, and `Err`
+// values are drawn from a small set of messages so that equal errors
+// occur. It panics if testing/quick cannot generate values of type T.
+func Generate[T any](rand *rand.Rand, size int) result.Result[T] {
+	if rand.Intn(2) == 0 {
+		return result.Err[T](fmt.Errorf("error %d", rand.Intn(4)))
+	}
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	v, ok := quick.Value(typ, rand)
+	if !ok {
+		panic(fmt.Sprintf("resulttest: cannot generate values of type %s", typ))
+	}
+	return result.Ok(v.Interface().(T))
+}
+
+// FromSeed returns the random result generated from the seed, for use
+// in fuzz targets which take an int64.
+func FromSeed[T any](seed int64) result.Result[T] {
+	return Generate[T](rand.New(rand.NewSource(seed)), 50)
+}
+
+// Arbitrary wraps a result so that it can be generated by testing/quick.
+type Arbitrary[T any] struct {
+	Result result.Result[T]
+}
+
+// Generate implements the quick.Generator interface.
+func (Arbitrary[T]) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Arbitrary[T]{Result: Generate[T](rand, size)})
+}
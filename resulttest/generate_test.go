@@ -0,0 +1,65 @@
+package resulttest_test
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/JustinKnueppel/go-result"
+	"github.com/JustinKnueppel/go-result/resulttest"
+)
+
+func TestGenerate(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	oks, errs := 0, 0
+	for i := 0; i < 100; i++ {
+		if resulttest.Generate[string](rng, 10).IsOk() {
+			oks++
+		} else {
+			errs++
+		}
+	}
+	if oks == 0 || errs == 0 {
+		t.Fail()
+	}
+}
+
+func TestGenerateUnsupported(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fail()
+		}
+	}()
+	rng := rand.New(rand.NewSource(0))
+	for i := 0; i < 100; i++ {
+		resulttest.Generate[any](rng, 10)
+	}
+}
+
+func TestFromSeed(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		if !result.Equal(resulttest.FromSeed[int](seed), resulttest.FromSeed[int](seed)) {
+			t.Fail()
+		}
+	}
+}
+
+func TestArbitrary(t *testing.T) {
+	property := func(a resulttest.Arbitrary[int]) bool {
+		return a.Result.IsOk() != a.Result.IsErr()
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func FuzzFromSeed(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(42))
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := resulttest.FromSeed[int](seed)
+		if !result.Equal(result.Map(r, func(i int) int { return i }), r) {
+			t.Fail()
+		}
+	})
+}
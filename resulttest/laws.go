@@ -0,0 +1,88 @@
+package resulttest
+
+import (
+	"testing/quick"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+// FunctorLaws checks that `mapFn` satisfies the functor laws for
+// randomly generated results, as result.Map does:
+//
+//   - identity: mapFn(r, id) == r
+//   - composition: mapFn(r, g∘f) == mapFn(mapFn(r, f), g)
+func FunctorLaws[T comparable](t TB, mapFn func(result.Result[T], func(T) T) result.Result[T], f, g func(T) T) {
+	t.Helper()
+	identity := func(a Arbitrary[T]) bool {
+		return result.Equal(mapFn(a.Result, func(x T) T { return x }), a.Result)
+	}
+	composition := func(a Arbitrary[T]) bool {
+		composed := mapFn(a.Result, func(x T) T { return g(f(x)) })
+		return result.Equal(composed, mapFn(mapFn(a.Result, f), g))
+	}
+	check(t, "functor identity", identity)
+	check(t, "functor composition", composition)
+}
+
+// MonadLaws checks that `bind` satisfies the monad laws with result.Ok
+// as unit for randomly generated results and values, as
+// result.AndThen does:
+//
+//   - left identity: bind(Ok(x), f) == f(x)
+//   - right identity: bind(r, Ok) == r
+//   - associativity: bind(bind(r, f), g) == bind(r, x => bind(f(x), g))
+func MonadLaws[T comparable](t TB, bind func(result.Result[T], func(T) result.Result[T]) result.Result[T], f, g func(T) result.Result[T]) {
+	t.Helper()
+	leftIdentity := func(x T) bool {
+		return result.Equal(bind(result.Ok(x), f), f(x))
+	}
+	rightIdentity := func(a Arbitrary[T]) bool {
+		return result.Equal(bind(a.Result, result.Ok[T]), a.Result)
+	}
+	associativity := func(a Arbitrary[T]) bool {
+		nested := bind(a.Result, func(x T) result.Result[T] { return bind(f(x), g) })
+		return result.Equal(bind(bind(a.Result, f), g), nested)
+	}
+	check(t, "monad left identity", leftIdentity)
+	check(t, "monad right identity", rightIdentity)
+	check(t, "monad associativity", associativity)
+}
+
+// OrAndLaws checks that `or` and `and` satisfy the algebra of
+// Result.Or and result.And for randomly generated results:
+//
+//   - or and and are associative
+//   - or(Ok(x), b) == Ok(x) and or(Err(e), b) == b
+//   - and(Ok(x), b) == b and and(Err(e), b) == Err(e)
+func OrAndLaws[T comparable](t TB, or, and func(a, b result.Result[T]) result.Result[T]) {
+	t.Helper()
+	orAssociativity := func(a, b, c Arbitrary[T]) bool {
+		return result.Equal(or(or(a.Result, b.Result), c.Result), or(a.Result, or(b.Result, c.Result)))
+	}
+	andAssociativity := func(a, b, c Arbitrary[T]) bool {
+		return result.Equal(and(and(a.Result, b.Result), c.Result), and(a.Result, and(b.Result, c.Result)))
+	}
+	orShortCircuit := func(a, b Arbitrary[T]) bool {
+		if a.Result.IsOk() {
+			return result.Equal(or(a.Result, b.Result), a.Result)
+		}
+		return result.Equal(or(a.Result, b.Result), b.Result)
+	}
+	andShortCircuit := func(a, b Arbitrary[T]) bool {
+		if a.Result.IsOk() {
+			return result.Equal(and(a.Result, b.Result), b.Result)
+		}
+		return result.Equal(and(a.Result, b.Result), a.Result)
+	}
+	check(t, "or associativity", orAssociativity)
+	check(t, "and associativity", andAssociativity)
+	check(t, "or short circuit", orShortCircuit)
+	check(t, "and short circuit", andShortCircuit)
+}
+
+func check(t TB, law string, property any) {
+	t.Helper()
+	if err := quick.Check(property, nil); err != nil {
+		t.Errorf("%s law failed: %v", law, err)
+	}
+}
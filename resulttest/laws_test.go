@@ -0,0 +1,113 @@
+package resulttest_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+	"github.com/JustinKnueppel/go-result/resulttest"
+)
+
+func half(i int) result.Result[int] {
+	if i%2 != 0 {
+		return result.Err[int](errors.New("odd"))
+	}
+	return result.Ok(i / 2)
+}
+
+func positive(i int) result.Result[int] {
+	if i <= 0 {
+		return result.Err[int](errors.New("not positive"))
+	}
+	return result.Ok(i)
+}
+
+func TestFunctorLaws(t *testing.T) {
+	tests := map[string]struct {
+		mapFn  func(result.Result[int], func(int) int) result.Result[int]
+		failed string
+	}{
+		"map": {
+			mapFn: result.Map[int, int],
+		},
+		"broken_identity": {
+			mapFn: func(r result.Result[int], f func(int) int) result.Result[int] {
+				return result.Map(r, func(i int) int { return f(i) + 1 })
+			},
+			failed: "functor identity law failed",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ft := run(func(tb resulttest.TB) {
+				resulttest.FunctorLaws(tb, tc.mapFn, func(i int) int { return i * 2 }, func(i int) int { return i - 3 })
+			})
+			if ft.failed != (tc.failed != "") || !strings.Contains(ft.msg, tc.failed) {
+				t.Errorf("unexpected result: %s", ft.msg)
+			}
+		})
+	}
+}
+
+func TestMonadLaws(t *testing.T) {
+	tests := map[string]struct {
+		bind   func(result.Result[int], func(int) result.Result[int]) result.Result[int]
+		failed string
+	}{
+		"and_then": {
+			bind: result.AndThen[int, int],
+		},
+		"broken_right_identity": {
+			bind: func(r result.Result[int], f func(int) result.Result[int]) result.Result[int] {
+				if r.IsErr() {
+					return result.Err[int](errors.New("replaced"))
+				}
+				return result.AndThen(r, f)
+			},
+			failed: "monad right identity law failed",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ft := run(func(tb resulttest.TB) { resulttest.MonadLaws(tb, tc.bind, half, positive) })
+			if ft.failed != (tc.failed != "") || !strings.Contains(ft.msg, tc.failed) {
+				t.Errorf("unexpected result: %s", ft.msg)
+			}
+		})
+	}
+}
+
+func TestOrAndLaws(t *testing.T) {
+	tests := map[string]struct {
+		or     func(a, b result.Result[int]) result.Result[int]
+		and    func(a, b result.Result[int]) result.Result[int]
+		failed string
+	}{
+		"or_and": {
+			or:  result.Result[int].Or,
+			and: result.And[int, int],
+		},
+		"broken_or": {
+			or: func(a, b result.Result[int]) result.Result[int] {
+				if b.IsOk() {
+					return b
+				}
+				return a
+			},
+			and:    result.And[int, int],
+			failed: "or short circuit law failed",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ft := run(func(tb resulttest.TB) { resulttest.OrAndLaws(tb, tc.or, tc.and) })
+			if ft.failed != (tc.failed != "") || !strings.Contains(ft.msg, tc.failed) {
+				t.Errorf("unexpected result: %s", ft.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Package resulttest provides test assertions for results.
+package resulttest
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+// TB is the subset of testing.TB used by the assertions and law checks.
+type TB interface {
+	Helper()
+	Errorf(format string, args ...any)
+	Fatalf(format string, args ...any)
+}
+
+// AssertOk returns the `Ok` value of the result, or fails the test
+// immediately if the result is `Err`.
+func AssertOk[T any](t TB, r result.Result[T]) T {
+	t.Helper()
+	if r.IsErr() {
+		t.Fatalf("expected Ok, got %s", r)
+	}
+	return r.Unwrap()
+}
+
+// AssertErr returns the `Err` value of the result, or fails the test
+// immediately if the result is `Ok`.
+func AssertErr[T any](t TB, r result.Result[T]) error {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("expected Err, got %s", r)
+	}
+	return r.UnwrapErr()
+}
+
+// AssertErrIs returns the `Err` value of the result, or fails the test
+// immediately if the result is `Ok` or its error does not match
+// `target` according to errors.Is.
+func AssertErrIs[T any](t TB, r result.Result[T], target error) error {
+	t.Helper()
+	err := AssertErr(t, r)
+	if !errors.Is(err, target) {
+		t.Fatalf("expected Err matching %q, got %s", target, r)
+	}
+	return err
+}
+
+// AssertErrAs returns the E contained in the `Err` value of the result
+// according to errors.As, or fails the test immediately if the result
+// is `Ok` or its error does not contain an E.
+func AssertErrAs[E error, T any](t TB, r result.Result[T]) E {
+	t.Helper()
+	err := AssertErr(t, r)
+	var e E
+	if !errors.As(err, &e) {
+		t.Fatalf("expected Err containing %s, got %s", reflect.TypeOf(&e).Elem(), r)
+	}
+	return e
+}
+
+// AssertOkEqual fails the test immediately if the result is `Err` or
+// its `Ok` value is not deeply equal to `want`, printing a line diff
+// of the two values.
+func AssertOkEqual[T any](t TB, r result.Result[T], want T) {
+	t.Helper()
+	got := AssertOk(t, r)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Ok value mismatch (-want +got):\n%s", Diff(want, got))
+	}
+}
@@ -0,0 +1,277 @@
+package resulttest_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+	"github.com/JustinKnueppel/go-result/resulttest"
+)
+
+type fakeT struct {
+	failed bool
+	msg    string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...any) {
+	f.failed = true
+	f.msg += fmt.Sprintf(format, args...) + "\n"
+}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.failed = true
+	f.msg = fmt.Sprintf(format, args...)
+	panic(f)
+}
+
+func run(assertion func(resulttest.TB)) (ft *fakeT) {
+	ft = &fakeT{}
+	defer func() {
+		if r := recover(); r != nil && r != ft {
+			panic(r)
+		}
+	}()
+	assertion(ft)
+	return ft
+}
+
+type pathError struct {
+	path string
+}
+
+func (e *pathError) Error() string {
+	return "bad path " + e.path
+}
+
+var errNotFound = errors.New("not found")
+
+func TestAssertOk(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		failed   bool
+		expected int
+		msg      string
+	}{
+		"success": {
+			value:    result.Ok(1),
+			expected: 1,
+		},
+		"error": {
+			value:  result.Err[int](errors.New("failed")),
+			failed: true,
+			msg:    "expected Ok, got Err(failed)",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var got int
+			ft := run(func(tb resulttest.TB) { got = resulttest.AssertOk(tb, tc.value) })
+			if ft.failed != tc.failed || ft.msg != tc.msg || got != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAssertErr(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[int]
+		failed bool
+		msg    string
+	}{
+		"success": {
+			value:  result.Ok(1),
+			failed: true,
+			msg:    "expected Err, got Ok(1)",
+		},
+		"error": {
+			value: result.Err[int](errNotFound),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var got error
+			ft := run(func(tb resulttest.TB) { got = resulttest.AssertErr(tb, tc.value) })
+			if ft.failed != tc.failed || ft.msg != tc.msg {
+				t.Fail()
+			}
+			if !tc.failed && got != errNotFound {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAssertErrIs(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[int]
+		failed bool
+		msg    string
+	}{
+		"success": {
+			value:  result.Ok(1),
+			failed: true,
+			msg:    "expected Err, got Ok(1)",
+		},
+		"error_match": {
+			value: result.Err[int](fmt.Errorf("user: %w", errNotFound)),
+		},
+		"error_mismatch": {
+			value:  result.Err[int](errors.New("other")),
+			failed: true,
+			msg:    `expected Err matching "not found", got Err(other)`,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ft := run(func(tb resulttest.TB) { resulttest.AssertErrIs(tb, tc.value, errNotFound) })
+			if ft.failed != tc.failed || ft.msg != tc.msg {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAssertErrAs(t *testing.T) {
+	tests := map[string]struct {
+		value  result.Result[int]
+		failed bool
+		path   string
+		msg    string
+	}{
+		"success": {
+			value:  result.Ok(1),
+			failed: true,
+			msg:    "expected Err, got Ok(1)",
+		},
+		"error_match": {
+			value: result.Err[int](fmt.Errorf("open: %w", &pathError{path: "/tmp"})),
+			path:  "/tmp",
+		},
+		"error_mismatch": {
+			value:  result.Err[int](errNotFound),
+			failed: true,
+			msg:    "expected Err containing *resulttest_test.pathError, got Err(not found)",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var got *pathError
+			ft := run(func(tb resulttest.TB) { got = resulttest.AssertErrAs[*pathError](tb, tc.value) })
+			if ft.failed != tc.failed || ft.msg != tc.msg {
+				t.Fail()
+			}
+			if !tc.failed && got.path != tc.path {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestAssertOkEqual(t *testing.T) {
+	type user struct {
+		Name  string
+		Roles []string
+	}
+	tests := map[string]struct {
+		value  result.Result[user]
+		want   user
+		failed bool
+		msg    []string
+	}{
+		"equal": {
+			value: result.Ok(user{Name: "a", Roles: []string{"admin"}}),
+			want:  user{Name: "a", Roles: []string{"admin"}},
+		},
+		"error": {
+			value:  result.Err[user](errNotFound),
+			want:   user{Name: "a"},
+			failed: true,
+			msg:    []string{"expected Ok, got Err(not found)"},
+		},
+		"mismatch": {
+			value:  result.Ok(user{Name: "a", Roles: []string{"admin", "dev"}}),
+			want:   user{Name: "a", Roles: []string{"admin"}},
+			failed: true,
+			msg:    []string{"(-want +got)", `+ 		"dev",`, `  	Name: "a",`},
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			ft := run(func(tb resulttest.TB) { resulttest.AssertOkEqual(tb, tc.value, tc.want) })
+			if ft.failed != tc.failed {
+				t.FailNow()
+			}
+			for _, part := range tc.msg {
+				if !strings.Contains(ft.msg, part) {
+					t.Errorf("message %q does not contain %q", ft.msg, part)
+				}
+			}
+		})
+	}
+}
+
+func TestFormat(t *testing.T) {
+	type inner struct {
+		value int
+	}
+	type outer struct {
+		Result result.Result[int]
+		Err    error
+		Inner  *inner
+		Tags   map[string]bool
+		Empty  []int
+	}
+	tests := map[string]struct {
+		value    any
+		expected string
+	}{
+		"nil": {
+			value:    nil,
+			expected: "nil",
+		},
+		"result": {
+			value:    result.Err[int](errNotFound),
+			expected: "Err(not found)",
+		},
+		"struct": {
+			value: outer{
+				Result: result.Ok(1),
+				Err:    errNotFound,
+				Inner:  &inner{value: 2},
+				Tags:   map[string]bool{"b": true, "a": false},
+			},
+			expected: strings.Join([]string{
+				"resulttest_test.outer{",
+				"\tResult: Ok(1),",
+				`	Err: error("not found"),`,
+				"\tInner: &resulttest_test.inner{",
+				"\t\tvalue: 2,",
+				"\t},",
+				"\tTags: map[string]bool{",
+				`		"a": false,`,
+				`		"b": true,`,
+				"\t},",
+				"\tEmpty: []int(nil),",
+				"}",
+			}, "\n"),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := resulttest.Format(tc.value); got != tc.expected {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+// Command resultvet reports misuse of results. It can be run on its own
+// or through go vet:
+//
+//	go vet -vettool=$(which resultvet) ./...
+package main
+
+import (
+	"github.com/JustinKnueppel/go-result/resultvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(resultvet.Analyzer)
+}
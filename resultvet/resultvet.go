@@ -0,0 +1,259 @@
+// Package resultvet defines an analyzer which reports misuse of results
+// from github.com/JustinKnueppel/go-result. It reports:
+//
+//   - results returned by calls which are silently discarded
+//   - calls to Unwrap which are not guarded by IsOk
+//   - calls to Err with a nil error, which create an `Ok` result
+//   - Result composite literals, which create an implicit `Ok` result
+package resultvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const resultPkgPath = "github.com/JustinKnueppel/go-result"
+
+// Analyzer reports misuse of results.
+var Analyzer = &analysis.Analyzer{
+	Name:     "resultvet",
+	Doc:      "report discarded results, unguarded Unwrap calls, Err(nil), and Result zero-value literals",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	insp := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+		(*ast.CallExpr)(nil),
+		(*ast.CompositeLit)(nil),
+	}
+	insp.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			checkDiscarded(pass, n.X)
+		case *ast.CallExpr:
+			checkErrNil(pass, n)
+			checkUnwrap(pass, n, stack)
+		case *ast.CompositeLit:
+			if pass.Pkg.Path() != resultPkgPath && isResult(pass.TypesInfo.TypeOf(n)) {
+				pass.Reportf(n.Pos(), "Result literal creates an implicit Ok result; use result.Ok or result.Err")
+			}
+		}
+		return true
+	})
+	return nil, nil
+}
+
+// isResult reports whether the type is an instance of Result, or an
+// alias of one.
+func isResult(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == resultPkgPath && obj.Name() == "Result"
+}
+
+// calledFunc returns the package level function or method called.
+func calledFunc(pass *analysis.Pass, call *ast.CallExpr) *types.Func {
+	fun := ast.Unparen(call.Fun)
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+	var id *ast.Ident
+	switch f := fun.(type) {
+	case *ast.Ident:
+		id = f
+	case *ast.SelectorExpr:
+		id = f.Sel
+	default:
+		return nil
+	}
+	fn, _ := pass.TypesInfo.Uses[id].(*types.Func)
+	return fn
+}
+
+// isResultFunc reports whether the function is the named function or
+// method of the result package.
+func isResultFunc(fn *types.Func, name string) bool {
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == resultPkgPath && fn.Name() == name
+}
+
+// checkDiscarded reports calls returning a result which is not used.
+// Calls to Inspect and InspectErr are allowed, as they are used for
+// their side effects, as are explicit assignments to the blank
+// identifier.
+func checkDiscarded(pass *analysis.Pass, e ast.Expr) {
+	call, ok := ast.Unparen(e).(*ast.CallExpr)
+	if !ok || !isResult(pass.TypesInfo.TypeOf(call)) {
+		return
+	}
+	fn := calledFunc(pass, call)
+	if isResultFunc(fn, "Inspect") || isResultFunc(fn, "InspectErr") {
+		return
+	}
+	pass.Reportf(call.Pos(), "result of call is discarded")
+}
+
+func checkErrNil(pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) != 1 || !isResultFunc(calledFunc(pass, call), "Err") {
+		return
+	}
+	if tv, ok := pass.TypesInfo.Types[call.Args[0]]; ok && tv.IsNil() {
+		pass.Reportf(call.Pos(), "Err(nil) creates an Ok result")
+	}
+}
+
+// checkUnwrap reports calls to Unwrap on a result, or a pointer to one,
+// which is not known to be `Ok`. A call is guarded if it is in the body of an if statement
+// whose condition requires the result to be `Ok`, in the else branch of
+// an if statement whose condition requires it to be `Err`, or follows
+// an if statement requiring it to be `Err` which always exits.
+func checkUnwrap(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || !isResultFunc(calledFunc(pass, call), "Unwrap") {
+		return
+	}
+	recv := pass.TypesInfo.TypeOf(sel.X)
+	if ptr, ok := types.Unalias(recv).(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	if !isResult(recv) {
+		return
+	}
+	if key, ok := guardKey(sel.X); ok && guarded(key, stack) {
+		return
+	}
+	pass.Reportf(call.Pos(), "Unwrap called without checking IsOk")
+}
+
+// guardKey returns a string identifying the receiver if it is a
+// variable or field which can be checked before unwrapping. A pointer
+// and the result it points to are identified by the same string.
+func guardKey(e ast.Expr) (string, bool) {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.StarExpr:
+		return guardKey(e.X)
+	case *ast.Ident:
+		return e.Name, true
+	case *ast.SelectorExpr:
+		if x, ok := guardKey(e.X); ok {
+			return x + "." + e.Sel.Name, true
+		}
+	}
+	return "", false
+}
+
+func guarded(key string, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		child := stack[i+1]
+		switch parent := stack[i].(type) {
+		case *ast.IfStmt:
+			if child == parent.Body && impliesOk(key, parent.Cond, true) {
+				return true
+			}
+			if child == parent.Else && impliesOk(key, parent.Cond, false) {
+				return true
+			}
+		case *ast.BlockStmt:
+			for _, stmt := range parent.List {
+				if stmt == child {
+					break
+				}
+				if ifStmt, ok := stmt.(*ast.IfStmt); ok && ifStmt.Else == nil &&
+					impliesOk(key, ifStmt.Cond, false) && exits(ifStmt.Body) {
+					return true
+				}
+			}
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		}
+	}
+	return false
+}
+
+// impliesOk reports whether the condition evaluating to `want` implies
+// that the result identified by key is `Ok`.
+func impliesOk(key string, cond ast.Expr, want bool) bool {
+	switch c := ast.Unparen(cond).(type) {
+	case *ast.UnaryExpr:
+		if c.Op == token.NOT {
+			return impliesOk(key, c.X, !want)
+		}
+	case *ast.BinaryExpr:
+		if c.Op == token.LAND && want {
+			return impliesOk(key, c.X, true) || impliesOk(key, c.Y, true)
+		}
+		if c.Op == token.LOR && !want {
+			return impliesOk(key, c.X, false) || impliesOk(key, c.Y, false)
+		}
+	case *ast.CallExpr:
+		sel, ok := ast.Unparen(c.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		if recv, ok := guardKey(sel.X); !ok || recv != key {
+			return false
+		}
+		switch sel.Sel.Name {
+		case "IsOk", "IsOkAnd":
+			return want
+		case "IsErr":
+			return !want
+		}
+	}
+	return false
+}
+
+// exits reports whether the block always ends by leaving the enclosing
+// block through a return, branch, panic, or a call such as t.Fatal,
+// log.Fatal, or os.Exit which does not return.
+func exits(body *ast.BlockStmt) bool {
+	if len(body.List) == 0 {
+		return false
+	}
+	switch last := body.List[len(body.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		switch fun := call.Fun.(type) {
+		case *ast.Ident:
+			return fun.Name == "panic"
+		case *ast.SelectorExpr:
+			return noReturn[fun.Sel.Name]
+		}
+	}
+	return false
+}
+
+// noReturn holds the names of common functions and methods which do
+// not return to their caller.
+var noReturn = map[string]bool{
+	"Exit":    true,
+	"Fatal":   true,
+	"Fatalf":  true,
+	"Fatalln": true,
+	"FailNow": true,
+	"Goexit":  true,
+	"Skip":    true,
+	"Skipf":   true,
+	"SkipNow": true,
+}
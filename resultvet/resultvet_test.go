@@ -0,0 +1,12 @@
+package resultvet_test
+
+import (
+	"testing"
+
+	"github.com/JustinKnueppel/go-result/resultvet"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), resultvet.Analyzer, "a")
+}
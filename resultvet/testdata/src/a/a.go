@@ -0,0 +1,164 @@
+package a
+
+import (
+	"errors"
+	"log"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func get() result.Result[int] {
+	return result.Ok(1)
+}
+
+type holder struct {
+	r result.Result[int]
+}
+
+func discarded() {
+	get() // want "result of call is discarded"
+	_ = get()
+	result.Map(get(), func(i int) int { return i })    // want "result of call is discarded"
+	get().MapErr(func(err error) error { return err }) // want "result of call is discarded"
+	get().Inspect(func(int) {}).InspectErr(func(error) {})
+	r := get()
+	_ = r
+}
+
+func errNil() {
+	_ = result.Err[int](nil) // want "Err\\(nil\\) creates an Ok result"
+	_ = result.Err[int](errors.New("failed"))
+}
+
+func literal() {
+	_ = result.Result[int]{} // want "Result literal creates an implicit Ok result"
+	_ = holder{}
+}
+
+func unguarded() int {
+	r := get()
+	return r.Unwrap() // want "Unwrap called without checking IsOk"
+}
+
+func unguardedCall() int {
+	return get().Unwrap() // want "Unwrap called without checking IsOk"
+}
+
+func unguardedWrongResult() int {
+	r, other := get(), get()
+	if other.IsOk() {
+		return r.Unwrap() // want "Unwrap called without checking IsOk"
+	}
+	return 0
+}
+
+func unguardedNegated() int {
+	r := get()
+	if !r.IsOk() {
+		return r.Unwrap() // want "Unwrap called without checking IsOk"
+	}
+	return 0
+}
+
+func unguardedClosure() func() int {
+	r := get()
+	if r.IsOk() {
+		return func() int {
+			return r.Unwrap() // want "Unwrap called without checking IsOk"
+		}
+	}
+	return nil
+}
+
+func guardedIf() int {
+	r := get()
+	if r.IsOk() {
+		return r.Unwrap()
+	}
+	return 0
+}
+
+func guardedAnd(enabled bool) int {
+	r := get()
+	if enabled && r.IsOkAnd(func(i int) bool { return i > 0 }) {
+		return r.Unwrap()
+	}
+	return 0
+}
+
+func guardedElse() int {
+	r := get()
+	if r.IsErr() {
+		return 0
+	} else {
+		return r.Unwrap()
+	}
+}
+
+func guardedEarlyReturn() int {
+	r := get()
+	if r.IsErr() {
+		return 0
+	}
+	return r.Unwrap()
+}
+
+func guardedEarlyPanic(h holder) int {
+	if !h.r.IsOk() {
+		panic("not ok")
+	}
+	return h.r.Unwrap()
+}
+
+func guardedLoop(rs []result.Result[int]) int {
+	total := 0
+	for _, r := range rs {
+		if r.IsErr() {
+			continue
+		}
+		total += r.Unwrap()
+	}
+	return total
+}
+
+func guardedEarlyFatal() int {
+	r := get()
+	if r.IsErr() {
+		log.Fatal("not ok")
+	}
+	return r.Unwrap()
+}
+
+type IntResult = result.Result[int]
+
+func aliased() IntResult {
+	return result.Ok(1)
+}
+
+func aliases() {
+	aliased() // want "result of call is discarded"
+	_ = aliased()
+	_ = IntResult{} // want "Result literal creates an implicit Ok result"
+}
+
+func unguardedPointer(r *result.Result[int]) int {
+	return r.Unwrap() // want "Unwrap called without checking IsOk"
+}
+
+func unguardedAliasPointer(r *IntResult) int {
+	return (*r).Unwrap() // want "Unwrap called without checking IsOk"
+}
+
+func guardedPointer(r *result.Result[int]) int {
+	if r.IsOk() {
+		return r.Unwrap()
+	}
+	return 0
+}
+
+func guardedDereference(r *IntResult) int {
+	if (*r).IsErr() {
+		return 0
+	}
+	return r.Unwrap()
+}
@@ -0,0 +1,22 @@
+// Package result is a stub of github.com/JustinKnueppel/go-result for
+// analyzer tests.
+package result
+
+type Result[T any] struct {
+	data T
+	err  error
+}
+
+func Ok[T any](data T) Result[T]     { return Result[T]{data: data} }
+func Err[T any](err error) Result[T] { return Result[T]{err: err} }
+
+func (r Result[T]) IsOk() bool                           { return r.err == nil }
+func (r Result[T]) IsErr() bool                          { return r.err != nil }
+func (r Result[T]) IsOkAnd(predicate func(T) bool) bool  { return r.IsOk() && predicate(r.data) }
+func (r Result[T]) Unwrap() T                            { return r.data }
+func (r Result[T]) UnwrapOr(fallback T) T                { return fallback }
+func (r Result[T]) Inspect(f func(T)) Result[T]          { return r }
+func (r Result[T]) InspectErr(f func(error)) Result[T]   { return r }
+func (r Result[T]) MapErr(f func(error) error) Result[T] { return r }
+
+func Map[T any, U any](r Result[T], f func(T) U) Result[U] { return Ok(f(r.data)) }
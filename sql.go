@@ -0,0 +1,136 @@
+package result
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// ErrNull is the error stored in an SQL result when a NULL value is
+// scanned and no NullErr has been configured.
+var ErrNull = errors.New("result: scanned NULL value")
+
+// SQL wraps a Result so it can be used as a destination in `Scan` and
+// as a query argument. Scanning NULL stores `Err` with NullErr (or
+// ErrNull if unset), while an `Err` result is written as NULL.
+type SQL[T any] struct {
+	Result  Result[T]
+	NullErr error
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *SQL[T]) Scan(src any) error {
+	if src == nil {
+		err := s.NullErr
+		if err == nil {
+			err = ErrNull
+		}
+		s.Result = Err[T](err)
+		return nil
+	}
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	s.Result = Ok(n.V)
+	return nil
+}
+
+// Value implements the driver.Valuer interface. The value is converted
+// as database/sql converts query arguments, calling its own Value
+// method if it implements driver.Valuer.
+func (s SQL[T]) Value() (driver.Value, error) {
+	if s.Result.IsErr() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(s.Result.data)
+}
+
+// ErrColumn adapts the error of a result to a nullable text column, so
+// that an `Err` can be stored next to the value written by SQL. A nil
+// Err is written as NULL, and a NULL column is scanned as a nil Err.
+// Errors are stored in the same form as MarshalText, so errors with a
+// registered code keep their identity. Columns holding a plain message
+// are scanned as an error with that message.
+type ErrColumn struct {
+	Err error
+}
+
+// ErrColumn returns the ErrColumn holding the error of the wrapped
+// result, if any.
+func (s SQL[T]) ErrColumn() ErrColumn {
+	return ErrColumn{Err: s.Result.err}
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *ErrColumn) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		c.Err = nil
+	case string:
+		c.Err = scanErrText(v)
+	case []byte:
+		c.Err = scanErrText(string(v))
+	default:
+		return fmt.Errorf("result: cannot scan %T into ErrColumn", src)
+	}
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (c ErrColumn) Value() (driver.Value, error) {
+	if c.Err == nil {
+		return nil, nil
+	}
+	return marshalErrText(c.Err), nil
+}
+
+func scanErrText(s string) error {
+	err, decErr := unmarshalErrText(s)
+	if decErr != nil {
+		return errors.New(s)
+	}
+	return err
+}
+
+// FromColumns combines a scanned value and error column into a single
+// result, preferring the stored error if there is one.
+func FromColumns[T any](value SQL[T], errCol ErrColumn) Result[T] {
+	if errCol.Err != nil {
+		return Err[T](errCol.Err)
+	}
+	return value.Result
+}
+
+// NoRowsError is the error returned by QueryRowResult when the query
+// did not select any rows. It unwraps to sql.ErrNoRows.
+type NoRowsError struct {
+	Query string
+}
+
+func (e *NoRowsError) Error() string {
+	return fmt.Sprintf("result: no rows for query %q", e.Query)
+}
+
+func (e *NoRowsError) Unwrap() error {
+	return sql.ErrNoRows
+}
+
+// RowQueryer is implemented by *sql.DB, *sql.Conn, and *sql.Tx.
+type RowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// QueryRowResult runs a query expected to return at most one row and
+// passes the row to `scanFn`. If no row was selected, `Err` is returned
+// with a *NoRowsError.
+func QueryRowResult[T any](ctx context.Context, db RowQueryer, query string, args []any, scanFn func(*sql.Row) Result[T]) Result[T] {
+	return scanFn(db.QueryRowContext(ctx, query, args...)).MapErr(func(err error) error {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &NoRowsError{Query: query}
+		}
+		return err
+	})
+}
@@ -0,0 +1,285 @@
+package result_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+var fakeTables = map[string][][]driver.Value{
+	"one":  {{int64(42)}},
+	"null": {{nil}},
+	"none": {},
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{query: query}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	query string
+}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	rows, ok := fakeTables[s.query]
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+	i    int
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.i >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.i])
+	r.i++
+	return nil
+}
+
+func init() {
+	sql.Register("resultfake", fakeDriver{})
+}
+
+func TestSQLScan(t *testing.T) {
+	customErr := errors.New("missing")
+	tests := map[string]struct {
+		src           any
+		nullErr       error
+		expected      result.Result[int]
+		errorExpected bool
+	}{
+		"int": {
+			src:      int64(5),
+			expected: result.Ok(5),
+		},
+		"string": {
+			src:      "7",
+			expected: result.Ok(7),
+		},
+		"null": {
+			src:      nil,
+			expected: result.Err[int](result.ErrNull),
+		},
+		"null_custom": {
+			src:      nil,
+			nullErr:  customErr,
+			expected: result.Err[int](customErr),
+		},
+		"invalid": {
+			src:           "abc",
+			errorExpected: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			s := result.SQL[int]{NullErr: tc.nullErr}
+			err := s.Scan(tc.src)
+			if (err != nil) != tc.errorExpected {
+				t.FailNow()
+			}
+			if !tc.errorExpected && !result.Equal(s.Result, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestSQLValue(t *testing.T) {
+	tests := map[string]struct {
+		value    result.Result[int]
+		expected driver.Value
+	}{
+		"success": {
+			value:    result.Ok(3),
+			expected: int64(3),
+		},
+		"error": {
+			value:    result.Err[int](errors.New("failed")),
+			expected: nil,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			v, err := result.SQL[int]{Result: tc.value}.Value()
+			if err != nil || v != tc.expected {
+				t.Fail()
+			}
+		})
+	}
+}
+
+type celsius float32
+
+type point struct {
+	x, y int
+}
+
+func (p point) Value() (driver.Value, error) {
+	return fmt.Sprintf("(%d,%d)", p.x, p.y), nil
+}
+
+func TestSQLValueConverted(t *testing.T) {
+	if v, err := (result.SQL[celsius]{Result: result.Ok[celsius](1.5)}).Value(); err != nil || v != float64(1.5) {
+		t.Fail()
+	}
+	if v, err := (result.SQL[point]{Result: result.Ok(point{1, 2})}).Value(); err != nil || v != "(1,2)" {
+		t.Fail()
+	}
+}
+
+func TestErrColumn(t *testing.T) {
+	tests := map[string]struct {
+		value       result.Result[int]
+		stored      driver.Value
+		expectedErr string
+	}{
+		"success": {
+			value:  result.Ok(1),
+			stored: nil,
+		},
+		"error": {
+			value:       result.Err[int](errors.New("failed")),
+			stored:      "err:failed",
+			expectedErr: "failed",
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			stored, err := result.SQL[int]{Result: tc.value}.ErrColumn().Value()
+			if err != nil || stored != tc.stored {
+				t.FailNow()
+			}
+			var col result.ErrColumn
+			if err := col.Scan(stored); err != nil {
+				t.FailNow()
+			}
+			if (col.Err == nil) != (tc.expectedErr == "") {
+				t.FailNow()
+			}
+			if col.Err != nil && col.Err.Error() != tc.expectedErr {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestErrColumnScanPlainMessage(t *testing.T) {
+	var col result.ErrColumn
+	if err := col.Scan([]byte("legacy failure")); err != nil {
+		t.FailNow()
+	}
+	if col.Err == nil || col.Err.Error() != "legacy failure" {
+		t.Fail()
+	}
+}
+
+func TestFromColumns(t *testing.T) {
+	tests := map[string]struct {
+		value    result.SQL[int]
+		errCol   result.ErrColumn
+		expected result.Result[int]
+	}{
+		"success": {
+			value:    result.SQL[int]{Result: result.Ok(1)},
+			errCol:   result.ErrColumn{},
+			expected: result.Ok(1),
+		},
+		"stored_error": {
+			value:    result.SQL[int]{Result: result.Err[int](result.ErrNull)},
+			errCol:   result.ErrColumn{Err: errors.New("failed")},
+			expected: result.Err[int](errors.New("failed")),
+		},
+		"null_without_stored_error": {
+			value:    result.SQL[int]{Result: result.Err[int](result.ErrNull)},
+			errCol:   result.ErrColumn{},
+			expected: result.Err[int](result.ErrNull),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if !result.Equal(result.FromColumns(tc.value, tc.errCol), tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestQueryRowResult(t *testing.T) {
+	db, err := sql.Open("resultfake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	scanInt := func(row *sql.Row) result.Result[int] {
+		var s result.SQL[int]
+		if err := row.Scan(&s); err != nil {
+			return result.Err[int](err)
+		}
+		return s.Result
+	}
+	tests := map[string]struct {
+		query       string
+		expected    result.Result[int]
+		noRowsError bool
+	}{
+		"one": {
+			query:    "one",
+			expected: result.Ok(42),
+		},
+		"null": {
+			query:    "null",
+			expected: result.Err[int](result.ErrNull),
+		},
+		"none": {
+			query:       "none",
+			expected:    result.Err[int](&result.NoRowsError{Query: "none"}),
+			noRowsError: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			r := result.QueryRowResult(context.Background(), db, tc.query, nil, scanInt)
+			if !result.Equal(r, tc.expected) {
+				t.Fail()
+			}
+			var noRows *result.NoRowsError
+			if r.IsErrAnd(func(err error) bool { return errors.As(err, &noRows) }) != tc.noRowsError {
+				t.Fail()
+			}
+			if r.ContainsErr(sql.ErrNoRows) != tc.noRowsError {
+				t.Fail()
+			}
+		})
+	}
+}
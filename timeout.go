@@ -0,0 +1,100 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WithTimeout calls `f` with a context which is cancelled after `d`. If
+// `f` does not return before the context is done, an `Err` wrapping the
+// context's error is returned without waiting for `f`, which should
+// return promptly once its context is cancelled.
+func WithTimeout[T any](ctx context.Context, d time.Duration, f func(context.Context) Result[T]) Result[T] {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	return await(ctx, f, func(err error) error {
+		return fmt.Errorf("result: function did not finish within %s: %w", d, err)
+	})
+}
+
+// WithDeadline calls `f` with a context which is cancelled at `deadline`.
+// If `f` does not return before the context is done, an `Err` wrapping
+// the context's error is returned without waiting for `f`, which should
+// return promptly once its context is cancelled.
+func WithDeadline[T any](ctx context.Context, deadline time.Time, f func(context.Context) Result[T]) Result[T] {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+	return await(ctx, f, func(err error) error {
+		return fmt.Errorf("result: function did not finish by %s: %w", deadline.Format(time.RFC3339Nano), err)
+	})
+}
+
+// await calls `f` in a new goroutine and returns its result, or an `Err`
+// built by `wrap` from the context's error if the context is done first.
+func await[T any](ctx context.Context, f func(context.Context) Result[T], wrap func(error) error) Result[T] {
+	done := make(chan Result[T], 1)
+	go func() {
+		done <- f(ctx)
+	}()
+	select {
+	case r := <-done:
+		return r
+	case <-ctx.Done():
+		return Err[T](wrap(ctx.Err()))
+	}
+}
+
+// Hedge calls `f`, and calls it a second time concurrently once `delay`
+// has passed or the first call has returned `Err`. The first `Ok` result is
+// returned and the context of the other call is cancelled. If both calls
+// return `Err`, their errors are joined in the order the calls started.
+// If the context is done first, an `Err` with the context's error is
+// returned.
+func Hedge[T any](ctx context.Context, delay time.Duration, f func(context.Context) Result[T]) Result[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := [2]chan Result[T]{make(chan Result[T], 1), make(chan Result[T], 1)}
+	start := func(i int) {
+		go func() {
+			results[i] <- f(ctx)
+		}()
+	}
+	start(0)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var errs [2]error
+	started, pending := 1, 1
+	for pending > 0 {
+		select {
+		case <-ctx.Done():
+			return Err[T](ctx.Err())
+		case <-timer.C:
+			if started == 1 {
+				start(1)
+				started, pending = 2, pending+1
+			}
+		case r := <-results[0]:
+			if r.IsOk() {
+				return r
+			}
+			errs[0] = r.err
+			pending--
+			// Start the second call early rather than waiting.
+			if started == 1 {
+				start(1)
+				started, pending = 2, pending+1
+			}
+		case r := <-results[1]:
+			if r.IsOk() {
+				return r
+			}
+			errs[1] = r.err
+			pending--
+		}
+	}
+	return Err[T](errors.Join(errs[0], errs[1]))
+}
@@ -0,0 +1,142 @@
+package result_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+// blockUntilDone returns a function which returns Err with the error of
+// its context once it is done, and reports that on the channel.
+func blockUntilDone(cancelled chan<- error) func(context.Context) result.Result[int] {
+	return func(ctx context.Context) result.Result[int] {
+		<-ctx.Done()
+		cancelled <- ctx.Err()
+		return result.Err[int](ctx.Err())
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	if r := result.WithTimeout(context.Background(), time.Second, func(context.Context) result.Result[int] {
+		return result.Ok(1)
+	}); !r.Equal(result.Ok(1)) {
+		t.Fail()
+	}
+
+	cancelled := make(chan error, 1)
+	r := result.WithTimeout(context.Background(), time.Millisecond, blockUntilDone(cancelled))
+	if !errors.Is(r.UnwrapErr(), context.DeadlineExceeded) {
+		t.Fail()
+	}
+	if !strings.Contains(r.UnwrapErr().Error(), "within 1ms") {
+		t.Fail()
+	}
+	if err := <-cancelled; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fail()
+	}
+}
+
+func TestWithDeadline(t *testing.T) {
+	if r := result.WithDeadline(context.Background(), time.Now().Add(time.Second), func(context.Context) result.Result[int] {
+		return result.Err[int](errors.New("failed"))
+	}); !r.Equal(result.Err[int](errors.New("failed"))) {
+		t.Fail()
+	}
+
+	cancelled := make(chan error, 1)
+	r := result.WithDeadline(context.Background(), time.Now().Add(time.Millisecond), blockUntilDone(cancelled))
+	if !errors.Is(r.UnwrapErr(), context.DeadlineExceeded) {
+		t.Fail()
+	}
+	<-cancelled
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r = result.WithDeadline(ctx, time.Now().Add(time.Hour), blockUntilDone(cancelled))
+	if !errors.Is(r.UnwrapErr(), context.Canceled) {
+		t.Fail()
+	}
+	<-cancelled
+}
+
+func TestHedge(t *testing.T) {
+	tests := map[string]struct {
+		delay    time.Duration
+		attempts []func(context.Context) result.Result[int]
+		expected result.Result[int]
+		calls    int32
+	}{
+		"first ok before delay": {
+			delay: time.Hour,
+			attempts: []func(context.Context) result.Result[int]{
+				func(context.Context) result.Result[int] { return result.Ok(1) },
+			},
+			expected: result.Ok(1),
+			calls:    1,
+		},
+		"second ok after delay": {
+			delay: time.Millisecond,
+			attempts: []func(context.Context) result.Result[int]{
+				func(ctx context.Context) result.Result[int] {
+					<-ctx.Done()
+					return result.Err[int](ctx.Err())
+				},
+				func(context.Context) result.Result[int] { return result.Ok(2) },
+			},
+			expected: result.Ok(2),
+			calls:    2,
+		},
+		"second started early after err": {
+			delay: time.Hour,
+			attempts: []func(context.Context) result.Result[int]{
+				func(context.Context) result.Result[int] { return result.Err[int](errors.New("first")) },
+				func(context.Context) result.Result[int] { return result.Ok(2) },
+			},
+			expected: result.Ok(2),
+			calls:    2,
+		},
+		"both err": {
+			delay: time.Hour,
+			attempts: []func(context.Context) result.Result[int]{
+				func(context.Context) result.Result[int] { return result.Err[int](errors.New("first")) },
+				func(context.Context) result.Result[int] { return result.Err[int](errors.New("second")) },
+			},
+			expected: result.Err[int](errors.New("first\nsecond")),
+			calls:    2,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			var calls atomic.Int32
+			r := result.Hedge(context.Background(), tc.delay, func(ctx context.Context) result.Result[int] {
+				n := calls.Add(1)
+				return tc.attempts[n-1](ctx)
+			})
+			if r.IsOk() != tc.expected.IsOk() || (r.IsOk() && !r.Equal(tc.expected)) ||
+				(r.IsErr() && r.UnwrapErr().Error() != tc.expected.UnwrapErr().Error()) {
+				t.Fail()
+			}
+			if calls.Load() != tc.calls {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestHedgeContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	r := result.Hedge(ctx, time.Hour, func(context.Context) result.Result[int] {
+		time.Sleep(time.Second)
+		return result.Ok(1)
+	})
+	if !errors.Is(r.UnwrapErr(), context.DeadlineExceeded) {
+		t.Fail()
+	}
+}
@@ -0,0 +1,62 @@
+package result
+
+// Traverse calls `f` on each element of the slice in order and collects
+// the `Ok` values, returning the first `Err` value without calling `f`
+// on the remaining elements.
+func Traverse[A any, B any](as []A, f func(A) Result[B]) Result[[]B] {
+	return TraverseIndexed(as, func(_ int, a A) Result[B] {
+		return f(a)
+	}).MapErr(unwrapKeyed)
+}
+
+// TraverseIndexed calls `f` on each index and element of the slice in
+// order and collects the `Ok` values. The first `Err` value is returned
+// with its error wrapped in a KeyedError holding its index, without
+// calling `f` on the remaining elements.
+func TraverseIndexed[A any, B any](as []A, f func(int, A) Result[B]) Result[[]B] {
+	bs := make([]B, len(as))
+	for i, a := range as {
+		r := f(i, a)
+		if r.IsErr() {
+			return Err[[]B](KeyedError{Key: i, Err: r.err})
+		}
+		bs[i] = r.data
+	}
+	return Ok(bs)
+}
+
+// TraverseMap calls `f` on each key and value of the map and collects
+// the `Ok` values by key. The first `Err` value, in map iteration order,
+// is returned without calling `f` on the remaining entries.
+func TraverseMap[K comparable, A any, B any](m map[K]A, f func(K, A) Result[B]) Result[map[K]B] {
+	return TraverseMapKeyed(m, f).MapErr(unwrapKeyed)
+}
+
+// TraverseMapKeyed calls `f` on each key and value of the map and
+// collects the `Ok` values by key. The first `Err` value, in map
+// iteration order, is returned with its error wrapped in a KeyedError
+// holding its key, without calling `f` on the remaining entries.
+func TraverseMapKeyed[K comparable, A any, B any](m map[K]A, f func(K, A) Result[B]) Result[map[K]B] {
+	bs := make(map[K]B, len(m))
+	for k, a := range m {
+		r := f(k, a)
+		if r.IsErr() {
+			return Err[map[K]B](KeyedError{Key: k, Err: r.err})
+		}
+		bs[k] = r.data
+	}
+	return Ok(bs)
+}
+
+func unwrapKeyed(err error) error {
+	return err.(KeyedError).Err
+}
+
+// Sequence converts from a map[K]Result[V] to a Result[map[K]V]. The
+// first `Err` value, in map iteration order, is returned with its error
+// wrapped in a KeyedError holding its key.
+func Sequence[K comparable, V any](m map[K]Result[V]) Result[map[K]V] {
+	return TraverseMapKeyed(m, func(_ K, r Result[V]) Result[V] {
+		return r
+	})
+}
@@ -0,0 +1,180 @@
+package result_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func atoi(s string) result.Result[int] {
+	return result.From(strconv.Atoi(s))
+}
+
+func TestTraverse(t *testing.T) {
+	tests := map[string]struct {
+		input    []string
+		expected result.Result[[]int]
+		calls    int
+	}{
+		"empty": {
+			input:    nil,
+			expected: result.Ok([]int{}),
+		},
+		"all ok": {
+			input:    []string{"1", "2", "3"},
+			expected: result.Ok([]int{1, 2, 3}),
+			calls:    3,
+		},
+		"stops at first err": {
+			input:    []string{"1", "x", "y"},
+			expected: result.Err[[]int](errors.New(`strconv.Atoi: parsing "x": invalid syntax`)),
+			calls:    2,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			calls := 0
+			got := result.Traverse(tc.input, func(s string) result.Result[int] {
+				calls++
+				return atoi(s)
+			})
+			if !equalSlices(got, tc.expected) {
+				t.Fail()
+			}
+			if calls != tc.calls {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTraverseIndexed(t *testing.T) {
+	tests := map[string]struct {
+		input    []string
+		expected result.Result[[]int]
+	}{
+		"all ok": {
+			input:    []string{"1", "2"},
+			expected: result.Ok([]int{10, 21}),
+		},
+		"err wrapped with index": {
+			input:    []string{"1", "x"},
+			expected: result.Err[[]int](errors.New(`[1]: strconv.Atoi: parsing "x": invalid syntax`)),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.TraverseIndexed(tc.input, func(i int, s string) result.Result[int] {
+				return result.Map(atoi(s), func(x int) int { return x*10 + i })
+			})
+			if !equalSlices(got, tc.expected) {
+				t.Fail()
+			}
+			var keyed result.KeyedError
+			if got.IsErr() && (!errors.As(got.UnwrapErr(), &keyed) || !errors.Is(got.UnwrapErr(), strconv.ErrSyntax)) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTraverseMap(t *testing.T) {
+	tests := map[string]struct {
+		input    map[string]string
+		expected result.Result[map[string]int]
+	}{
+		"empty": {
+			input:    nil,
+			expected: result.Ok(map[string]int{}),
+		},
+		"all ok": {
+			input:    map[string]string{"a": "1", "b": "2"},
+			expected: result.Ok(map[string]int{"a": 1, "b": 2}),
+		},
+		"err": {
+			input:    map[string]string{"a": "1", "b": "x"},
+			expected: result.Err[map[string]int](errors.New(`strconv.Atoi: parsing "x": invalid syntax`)),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.TraverseMap(tc.input, func(_ string, s string) result.Result[int] {
+				return atoi(s)
+			})
+			if !equalMaps(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestTraverseMapKeyed(t *testing.T) {
+	tests := map[string]struct {
+		input    map[string]string
+		expected result.Result[map[string]int]
+	}{
+		"all ok": {
+			input:    map[string]string{"a": "1", "b": "2"},
+			expected: result.Ok(map[string]int{"a": 1, "b": 2}),
+		},
+		"err wrapped with key": {
+			input:    map[string]string{"a": "1", "b": "x"},
+			expected: result.Err[map[string]int](errors.New(`[b]: strconv.Atoi: parsing "x": invalid syntax`)),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			got := result.TraverseMapKeyed(tc.input, func(_ string, s string) result.Result[int] {
+				return atoi(s)
+			})
+			if !equalMaps(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func equalMaps[K comparable, V any](r, other result.Result[map[K]V]) bool {
+	if r.IsOk() && other.IsOk() {
+		return reflect.DeepEqual(r.Unwrap(), other.Unwrap())
+	}
+	if r.IsErr() && other.IsErr() {
+		return r.UnwrapErr().Error() == other.UnwrapErr().Error()
+	}
+	return false
+}
+
+func TestSequence(t *testing.T) {
+	tests := map[string]struct {
+		input    map[string]result.Result[int]
+		expected result.Result[map[string]int]
+	}{
+		"empty": {
+			input:    nil,
+			expected: result.Ok(map[string]int{}),
+		},
+		"all ok": {
+			input:    map[string]result.Result[int]{"a": result.Ok(1), "b": result.Ok(2)},
+			expected: result.Ok(map[string]int{"a": 1, "b": 2}),
+		},
+		"err wrapped with key": {
+			input:    map[string]result.Result[int]{"a": result.Ok(1), "b": result.Err[int](errors.New("failed"))},
+			expected: result.Err[map[string]int](errors.New("[b]: failed")),
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			if got := result.Sequence(tc.input); !equalMaps(got, tc.expected) {
+				t.Fail()
+			}
+		})
+	}
+}
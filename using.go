@@ -0,0 +1,25 @@
+package result
+
+import "errors"
+
+// Using acquires a resource, passes it to `use`, and releases it once
+// `use` returns or panics. If `acquire` returns `Err`, its error is
+// returned and neither `use` nor `release` is called. An error returned
+// by `release` is joined with the error of `use`, or replaces its `Ok`
+// value.
+func Using[R any, T any](acquire func() Result[R], release func(R) error, use func(R) Result[T]) (res Result[T]) {
+	resource := acquire()
+	if resource.IsErr() {
+		return Err[T](resource.err)
+	}
+	defer func() {
+		if err := release(resource.data); err != nil {
+			if res.IsErr() {
+				res = Err[T](errors.Join(res.err, err))
+			} else {
+				res = Err[T](err)
+			}
+		}
+	}()
+	return use(resource.data)
+}
@@ -0,0 +1,99 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/JustinKnueppel/go-result"
+)
+
+func TestUsing(t *testing.T) {
+	tests := map[string]struct {
+		acquire  result.Result[string]
+		release  error
+		use      result.Result[int]
+		expected result.Result[int]
+		released bool
+	}{
+		"ok": {
+			acquire:  result.Ok("resource"),
+			use:      result.Ok(1),
+			expected: result.Ok(1),
+			released: true,
+		},
+		"acquire err": {
+			acquire:  result.Err[string](errors.New("acquire")),
+			use:      result.Ok(1),
+			expected: result.Err[int](errors.New("acquire")),
+			released: false,
+		},
+		"use err": {
+			acquire:  result.Ok("resource"),
+			use:      result.Err[int](errors.New("use")),
+			expected: result.Err[int](errors.New("use")),
+			released: true,
+		},
+		"release err": {
+			acquire:  result.Ok("resource"),
+			release:  errors.New("release"),
+			use:      result.Ok(1),
+			expected: result.Err[int](errors.New("release")),
+			released: true,
+		},
+		"use and release err": {
+			acquire:  result.Ok("resource"),
+			release:  errors.New("release"),
+			use:      result.Err[int](errors.New("use")),
+			expected: result.Err[int](errors.New("use\nrelease")),
+			released: true,
+		},
+	}
+
+	for tname, tc := range tests {
+		t.Run(tname, func(t *testing.T) {
+			released := false
+			got := result.Using(
+				func() result.Result[string] { return tc.acquire },
+				func(r string) error {
+					if r != "resource" {
+						t.Fail()
+					}
+					released = true
+					return tc.release
+				},
+				func(r string) result.Result[int] {
+					if r != "resource" {
+						t.Fail()
+					}
+					return tc.use
+				},
+			)
+			if got.IsOk() != tc.expected.IsOk() || (got.IsOk() && !got.Equal(tc.expected)) ||
+				(got.IsErr() && got.UnwrapErr().Error() != tc.expected.UnwrapErr().Error()) {
+				t.Fail()
+			}
+			if released != tc.released {
+				t.Fail()
+			}
+		})
+	}
+}
+
+func TestUsingPanic(t *testing.T) {
+	released := false
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fail()
+			}
+		}()
+		result.Using(
+			func() result.Result[int] { return result.Ok(1) },
+			func(int) error { released = true; return nil },
+			func(int) result.Result[int] { panic("failed") },
+		)
+	}()
+	if !released {
+		t.Fail()
+	}
+}